@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 	"io"
 	"os"
 	"os/signal"
@@ -15,26 +17,41 @@ import (
 	"github.com/devops-pipeflow/insight-plugin/config"
 	"github.com/devops-pipeflow/insight-plugin/gpt"
 	"github.com/devops-pipeflow/insight-plugin/insight"
+	"github.com/devops-pipeflow/insight-plugin/linters"
+	"github.com/devops-pipeflow/insight-plugin/mail"
 	"github.com/devops-pipeflow/insight-plugin/proto"
 	"github.com/devops-pipeflow/insight-plugin/repo"
 	"github.com/devops-pipeflow/insight-plugin/review"
+	"github.com/devops-pipeflow/insight-plugin/server"
 	"github.com/devops-pipeflow/insight-plugin/sights"
 	"github.com/devops-pipeflow/insight-plugin/ssh"
 )
 
 const (
-	level = "INFO"
-	name  = "insight"
+	address = ":9090"
+	input   = "-"
+	level   = "INFO"
+	name    = "insight"
 )
 
 var (
 	app        = kingpin.New(name, "insight plugin")
 	configFile = app.Flag("config-file", "Config file (.yml)").Required().String()
 	logLevel   = app.Flag("log-level", "Log level (DEBUG|INFO|WARN|ERROR)").Default(level).String()
+
+	serveCmd      = app.Command("serve", "Serve insight service").Default()
+	listenAddress = serveCmd.Flag("listen-address", "Listen address (host:port)").Default(address).String()
+
+	triggerCmd   = app.Command("trigger", "Trigger insight once")
+	triggerInput = triggerCmd.Flag("input", "Trigger request file (.json, - for stdin)").Default(input).String()
+)
+
+var (
+	ErrTrigger = errors.New("trigger failed")
 )
 
 func Run(ctx context.Context) error {
-	kingpin.MustParse(app.Parse(os.Args[1:]))
+	command := kingpin.MustParse(app.Parse(os.Args[1:]))
 
 	logger, err := initLogger(ctx, *logLevel)
 	if err != nil {
@@ -56,8 +73,17 @@ func Run(ctx context.Context) error {
 		return errors.Wrap(err, "failed to init insight")
 	}
 
-	if err := runInsight(ctx, logger, i); err != nil {
-		return errors.Wrap(err, "failed to run insight")
+	if command == triggerCmd.FullCommand() {
+		return runTrigger(ctx, logger, i, *triggerInput)
+	}
+
+	s, err := initServer(ctx, logger, cfg, i)
+	if err != nil {
+		return errors.Wrap(err, "failed to init server")
+	}
+
+	if err := runServer(ctx, logger, s); err != nil {
+		return errors.Wrap(err, "failed to run server")
 	}
 
 	return nil
@@ -90,6 +116,10 @@ func initConfig(_ context.Context, logger hclog.Logger, name string) (*config.Co
 		return c, errors.Wrap(err, "failed to unmarshal")
 	}
 
+	if err := c.Validate(); err != nil {
+		return c, errors.Wrap(err, "failed to validate")
+	}
+
 	return c, nil
 }
 
@@ -111,6 +141,10 @@ func initSights(ctx context.Context, logger hclog.Logger, cfg *config.Config) (s
 		v.Config = *cfg
 		v.Logger = logger
 		c.Review = review.New(ctx, v)
+		m := mail.DefaultConfig()
+		m.Config = *cfg
+		m.Logger = logger
+		c.Mail = mail.New(ctx, m)
 		return sights.BuildSightNew(ctx, c)
 	}
 
@@ -130,6 +164,37 @@ func initSights(ctx context.Context, logger hclog.Logger, cfg *config.Config) (s
 		v.Config = *cfg
 		v.Logger = logger
 		c.Review = review.New(ctx, v)
+		m := mail.DefaultConfig()
+		m.Config = *cfg
+		m.Logger = logger
+		c.Mail = mail.New(ctx, m)
+		cl := linters.DefaultCommitLinterConfig()
+		cl.Config = *cfg
+		cl.Logger = logger
+		gl := linters.DefaultGptLinterConfig()
+		gl.Config = *cfg
+		gl.Logger = logger
+		gl.Gpt = gpt.New(ctx, g)
+		kl := linters.DefaultKernelLinterConfig()
+		kl.Config = *cfg
+		kl.Logger = logger
+		ll := linters.DefaultLicenseLinterConfig()
+		ll.Config = *cfg
+		ll.Logger = logger
+		ml := linters.DefaultMegaLinterConfig()
+		ml.Config = *cfg
+		ml.Logger = logger
+		sl := linters.DefaultSecretLinterConfig()
+		sl.Config = *cfg
+		sl.Logger = logger
+		c.Linters = map[string]sights.Linter{
+			sights.LinterCommit:  linters.CommitLinterNew(ctx, cl),
+			sights.LinterGpt:     linters.GptLinterNew(ctx, gl),
+			sights.LinterKernel:  linters.KernelLinterNew(ctx, kl),
+			sights.LinterLicense: linters.LicenseLinterNew(ctx, ll),
+			sights.LinterMega:    linters.MegaLinterNew(ctx, ml),
+			sights.LinterSecret:  linters.SecretLinterNew(ctx, sl),
+		}
 		return sights.CodeSightNew(ctx, c)
 	}
 
@@ -145,6 +210,10 @@ func initSights(ctx context.Context, logger hclog.Logger, cfg *config.Config) (s
 		s.Config = *cfg
 		s.Logger = logger
 		c.Ssh = ssh.New(ctx, s)
+		m := mail.DefaultConfig()
+		m.Config = *cfg
+		m.Logger = logger
+		c.Mail = mail.New(ctx, m)
 		return sights.NodeSightNew(ctx, c)
 	}
 
@@ -172,38 +241,128 @@ func initInsight(ctx context.Context, logger hclog.Logger, cfg *config.Config,
 	return insight.New(ctx, c), nil
 }
 
-func runInsight(ctx context.Context, logger hclog.Logger, i insight.Insight) error {
-	logger.Debug("cmd: runInsight")
+func initServer(ctx context.Context, logger hclog.Logger, cfg *config.Config, i insight.Insight) (server.Server, error) {
+	logger.Debug("cmd: initServer")
 
-	var buildTrigger proto.BuildTrigger
-	var codeTrigger proto.CodeTrigger
-	var nodeTrigger proto.NodeTrigger
+	reload := func(ctx context.Context, cfg *config.Config) (insight.Insight, error) {
+		bs, cs, ns, err := initSights(ctx, logger, cfg)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to init sights")
+		}
+		return initInsight(ctx, logger, cfg, bs, cs, ns)
+	}
 
-	if err := i.Init(ctx); err != nil {
+	c := server.DefaultConfig()
+	if c == nil {
+		return nil, errors.New("failed to config")
+	}
+
+	c.Addr = *listenAddress
+	c.Config = *cfg
+	c.Logger = logger
+
+	c.Insight = i
+	c.Reload = reload
+
+	return server.New(ctx, c), nil
+}
+
+func runServer(ctx context.Context, logger hclog.Logger, srv server.Server) error {
+	logger.Debug("cmd: runServer")
+
+	if err := srv.Init(ctx); err != nil {
 		return errors.Wrap(err, "failed to init")
 	}
 
 	s := make(chan os.Signal, 1)
-	done := make(chan bool, 1)
 
 	// kill (no param) default send syscanll.SIGTERM
 	// kill -2 is syscall.SIGINT
 	// kill -9 is syscall.SIGKILL but can"t be caught, so don't need add it
 	signal.Notify(s, syscall.SIGINT, syscall.SIGTERM)
 
-	go func(ctx context.Context, buildTrigger *proto.BuildTrigger, codeTrigger *proto.CodeTrigger, nodeTrigger *proto.NodeTrigger) {
-		logger.Debug("cmd: runInsight: Run")
-		_, _, _, _, _ = i.Run(ctx, buildTrigger, codeTrigger, nodeTrigger)
-	}(ctx, &buildTrigger, &codeTrigger, &nodeTrigger)
-
-	go func(ctx context.Context, i insight.Insight, s chan os.Signal) {
-		logger.Debug("cmd: runInsight: Deinit")
+	go func(ctx context.Context, srv server.Server, s chan os.Signal) {
+		logger.Debug("cmd: runServer: Deinit")
 		<-s
+		_ = srv.Deinit(ctx)
+	}(ctx, srv, s)
+
+	logger.Debug("cmd: runServer: Run")
+
+	if err := srv.Run(ctx); err != nil {
+		return errors.Wrap(err, "failed to run")
+	}
+
+	return nil
+}
+
+func loadTrigger(_ context.Context, logger hclog.Logger, name string) (*proto.TriggerRequest, error) {
+	logger.Debug("cmd: loadTrigger")
+
+	var buf []byte
+	var err error
+
+	if name == input {
+		buf, err = io.ReadAll(os.Stdin)
+	} else {
+		buf, err = os.ReadFile(name)
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read")
+	}
+
+	var req proto.TriggerRequest
+
+	if err := json.Unmarshal(buf, &req); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal")
+	}
+
+	return &req, nil
+}
+
+func runTrigger(ctx context.Context, logger hclog.Logger, i insight.Insight, name string) error {
+	logger.Debug("cmd: runTrigger")
+
+	req, err := loadTrigger(ctx, logger, name)
+	if err != nil {
+		return errors.Wrap(err, "failed to load trigger")
+	}
+
+	if err := i.Init(ctx); err != nil {
+		return errors.Wrap(err, "failed to init")
+	}
+
+	defer func(ctx context.Context, i insight.Insight) {
 		_ = i.Deinit(ctx)
-		done <- true
-	}(ctx, i, s)
+	}(ctx, i)
+
+	buildInfo, codeInfo, mailInfo, nodeInfo, err := i.Run(ctx, req.BuildTrigger, req.CodeTrigger, req.NodeTrigger)
+	if err != nil {
+		return errors.Wrap(err, "failed to run")
+	}
+
+	rsp := proto.TriggerResponse{
+		BuildInfo: buildInfo,
+		CodeInfo:  codeInfo,
+		MailInfo:  mailInfo,
+		NodeInfo:  nodeInfo,
+	}
+
+	buf, err := json.MarshalIndent(rsp, "", "  ")
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal")
+	}
 
-	<-done
+	fmt.Println(string(buf))
+
+	if checkTrigger(&rsp) {
+		return ErrTrigger
+	}
 
 	return nil
 }
+
+func checkTrigger(rsp *proto.TriggerResponse) bool {
+	return rsp.BuildInfo.Error != "" || rsp.CodeInfo.Error != "" || rsp.NodeInfo.Error != ""
+}
@@ -10,6 +10,7 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/devops-pipeflow/insight-plugin/config"
+	"github.com/devops-pipeflow/insight-plugin/proto"
 )
 
 func testInitConfig() *config.Config {
@@ -65,3 +66,37 @@ func TestInitInsight(t *testing.T) {
 	_, err := initInsight(context.Background(), logger, cfg, nil, nil, nil)
 	assert.Equal(t, nil, err)
 }
+
+func TestInitServer(t *testing.T) {
+	logger, _ := initLogger(context.Background(), level)
+	cfg := testInitConfig()
+
+	_, err := initServer(context.Background(), logger, cfg, nil)
+	assert.Equal(t, nil, err)
+}
+
+func TestLoadTrigger(t *testing.T) {
+	logger, _ := initLogger(context.Background(), level)
+	ctx := context.Background()
+
+	_, err := loadTrigger(ctx, logger, "invalid.json")
+	assert.NotEqual(t, nil, err)
+
+	_, err = loadTrigger(ctx, logger, "../test/config/config.yml")
+	assert.NotEqual(t, nil, err)
+
+	req, err := loadTrigger(ctx, logger, "../test/cmd/trigger.json")
+	assert.Equal(t, nil, err)
+	assert.NotEqual(t, (*proto.BuildTrigger)(nil), req.BuildTrigger)
+	assert.Equal(t, (*proto.CodeTrigger)(nil), req.CodeTrigger)
+	assert.Equal(t, (*proto.NodeTrigger)(nil), req.NodeTrigger)
+	assert.Equal(t, 2, len(req.BuildTrigger.LoggingTrigger.Lines))
+}
+
+func TestCheckTrigger(t *testing.T) {
+	rsp := proto.TriggerResponse{}
+	assert.Equal(t, false, checkTrigger(&rsp))
+
+	rsp.NodeInfo.Error = "error"
+	assert.Equal(t, true, checkTrigger(&rsp))
+}
@@ -1,5 +1,42 @@
 package config
 
+import (
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	contentHtml  = "text/html"
+	contentPlain = "text/plain"
+	extensionSep = "."
+)
+
+const (
+	PullAlways       = "always"
+	PullIfNotPresent = "if-not-present"
+	PullNever        = "never"
+)
+
+const (
+	StyleConventional = "conventional"
+	StyleModule       = "module"
+)
+
+const (
+	digestPrefix = "sha256:"
+)
+
+var (
+	licenseExpr = regexp.MustCompile(`^[A-Za-z0-9.+\-:() ]+$`)
+	mailSights  = []string{"buildsight", "codesight", "nodesight"}
+)
+
 type Config struct {
 	ApiVersion string   `yaml:"apiVersion"`
 	Kind       string   `yaml:"kind"`
@@ -21,6 +58,7 @@ type Spec struct {
 	RepoConfig     RepoConfig     `yaml:"repoConfig"`
 	ReviewConfig   ReviewConfig   `yaml:"reviewConfig"`
 	SshConfig      SshConfig      `yaml:"sshConfig"`
+	MailConfig     MailConfig     `yaml:"mailConfig"`
 }
 
 type EnvVariable struct {
@@ -29,10 +67,16 @@ type EnvVariable struct {
 }
 
 type BuildConfig struct {
+	Duration      string        `yaml:"duration"`
 	LoggingConfig LoggingConfig `yaml:"loggingConfig"`
 }
 
-type CodeConfig struct{}
+type CodeConfig struct {
+	Duration    string       `yaml:"duration"`
+	LintConfigs []LintConfig `yaml:"lintConfigs"`
+	LintVote    LintVote     `yaml:"lintVote"`
+	MegaConfig  MegaConfig   `yaml:"megaConfig"`
+}
 
 type NodeConfig struct {
 	Duration string `yaml:"duration"`
@@ -71,12 +115,261 @@ type SshConfig struct {
 	Timeout string `yaml:"timeout"`
 }
 
+type MailConfig struct {
+	ContentType string         `yaml:"contentType"`
+	FromAddress string         `yaml:"fromAddress"`
+	Templates   []MailTemplate `yaml:"templates"`
+}
+
+// LoggingConfig sets the failing regions of buildsight: Start is the default line to start
+// (>=1) if LoggingTrigger.Start is not set, Len is the lines per region (not the window of
+// LoggingTrigger.Len) and Count is the regions at most.
 type LoggingConfig struct {
 	Start int64 `yaml:"start"`
 	Len   int64 `yaml:"len"`
 	Count int64 `yaml:"count"`
 }
 
+type LintConfig struct {
+	Name       string     `yaml:"name"`
+	Extensions []string   `yaml:"extensions"`
+	Files      []string   `yaml:"files"`
+	Projects   []string   `yaml:"projects"`
+	Rules      []LintRule `yaml:"rules"`
+}
+
+type LintRule struct {
+	Name           string        `yaml:"name"`
+	Disabled       bool          `yaml:"disabled"`
+	Extensions     []string      `yaml:"extensions"`
+	Files          []string      `yaml:"files"`
+	SubjectMin     int64         `yaml:"subjectMin"`
+	SubjectMax     int64         `yaml:"subjectMax"`
+	DescriptionMax int64         `yaml:"descriptionMax"`
+	SubjectStyle   string        `yaml:"subjectStyle"`
+	Trailers       []string      `yaml:"trailers"`
+	Allowlist      LintAllowlist `yaml:"allowlist"`
+	License        string        `yaml:"license"`
+	Copyright      string        `yaml:"copyright"`
+	Header         string        `yaml:"header"`
+	SizeMax        int64         `yaml:"sizeMax"`
+	Denylist       []string      `yaml:"denylist"`
+	IllegalChars   string        `yaml:"illegalChars"`
+	Prompt         string        `yaml:"prompt"`
+	Tokens         int64         `yaml:"tokens"`
+}
+
+type LintAllowlist struct {
+	Paths   []string `yaml:"paths"`
+	Regexes []string `yaml:"regexes"`
+}
+
+type LintVote struct {
+	Approval    string      `yaml:"approval"`
+	Disapproval string      `yaml:"disapproval"`
+	Label       string      `yaml:"label"`
+	Message     string      `yaml:"message"`
+	Labels      []LintLabel `yaml:"labels"`
+	CommentOnly bool        `yaml:"commentOnly"`
+}
+
+type LintLabel struct {
+	Name        string `yaml:"name"`
+	Approval    string `yaml:"approval"`
+	Disapproval string `yaml:"disapproval"`
+}
+
+type MegaConfig struct {
+	Registry   string `yaml:"registry"`
+	Image      string `yaml:"image"`
+	Tag        string `yaml:"tag"`
+	Digest     string `yaml:"digest"`
+	PullPolicy string `yaml:"pullPolicy"`
+}
+
+type MailTemplate struct {
+	Name    string `yaml:"name"`
+	Subject string `yaml:"subject"`
+	Body    string `yaml:"body"`
+}
+
 func New() *Config {
 	return &Config{}
 }
+
+// Validate checks the settings which would fail the sights at runtime, the empty ones are
+// valid and fall back to defaults.
+// nolint: gocyclo
+func (c *Config) Validate() error {
+	duration := func(data string) error {
+		if data == "" {
+			return nil
+		}
+		d, err := time.ParseDuration(data)
+		if err != nil {
+			return err
+		}
+		if d <= 0 {
+			return errors.New("non-positive duration " + data)
+		}
+		return nil
+	}
+
+	vote := func(data string) error {
+		if data == "" {
+			return nil
+		}
+		if _, err := strconv.Atoi(data); err != nil {
+			return errors.New("invalid vote " + data)
+		}
+		return nil
+	}
+
+	spec := &c.Spec
+
+	if err := duration(spec.BuildConfig.Duration); err != nil {
+		return errors.Wrap(err, "invalid buildConfig.duration")
+	}
+
+	if l := spec.BuildConfig.LoggingConfig; l.Start < 0 || l.Len < 0 || l.Count < 0 {
+		return errors.New("invalid buildConfig.loggingConfig")
+	}
+
+	if err := duration(spec.CodeConfig.Duration); err != nil {
+		return errors.Wrap(err, "invalid codeConfig.duration")
+	}
+
+	for _, item := range spec.CodeConfig.LintConfigs {
+		if item.Name == "" {
+			return errors.New("invalid codeConfig.lintConfigs.name")
+		}
+		for _, ext := range item.Extensions {
+			if !strings.HasPrefix(ext, extensionSep) {
+				return errors.New("invalid codeConfig.lintConfigs.extensions " + ext)
+			}
+		}
+		for _, rule := range item.Rules {
+			if err := validateRule(&rule); err != nil {
+				return errors.Wrap(err, "invalid codeConfig.lintConfigs.rules")
+			}
+		}
+	}
+
+	v := spec.CodeConfig.LintVote
+
+	if err := vote(v.Approval); err != nil {
+		return errors.Wrap(err, "invalid codeConfig.lintVote.approval")
+	}
+
+	if err := vote(v.Disapproval); err != nil {
+		return errors.Wrap(err, "invalid codeConfig.lintVote.disapproval")
+	}
+
+	if (v.Approval != "" || v.Disapproval != "") && v.Label == "" && len(v.Labels) == 0 {
+		return errors.New("invalid codeConfig.lintVote.label")
+	}
+
+	for _, item := range v.Labels {
+		if item.Name == "" {
+			return errors.New("invalid codeConfig.lintVote.labels.name")
+		}
+		if err := vote(item.Approval); err != nil {
+			return errors.Wrap(err, "invalid codeConfig.lintVote.labels.approval")
+		}
+		if err := vote(item.Disapproval); err != nil {
+			return errors.Wrap(err, "invalid codeConfig.lintVote.labels.disapproval")
+		}
+	}
+
+	m := spec.CodeConfig.MegaConfig
+
+	if m.Digest != "" && !strings.HasPrefix(m.Digest, digestPrefix) {
+		return errors.New("invalid codeConfig.megaConfig.digest " + m.Digest)
+	}
+
+	if p := m.PullPolicy; p != "" && p != PullAlways && p != PullIfNotPresent && p != PullNever {
+		return errors.New("invalid codeConfig.megaConfig.pullPolicy " + p)
+	}
+
+	if err := duration(spec.NodeConfig.Duration); err != nil {
+		return errors.Wrap(err, "invalid nodeConfig.duration")
+	}
+
+	if err := duration(spec.SshConfig.Timeout); err != nil {
+		return errors.Wrap(err, "invalid sshConfig.timeout")
+	}
+
+	if t := spec.MailConfig.ContentType; t != "" && t != contentHtml && t != contentPlain {
+		return errors.New("invalid mailConfig.contentType " + t)
+	}
+
+	for _, item := range spec.MailConfig.Templates {
+		if !slices.Contains(mailSights, item.Name) {
+			return errors.New("invalid mailConfig.templates.name " + item.Name)
+		}
+		if _, err := template.New("subject").Parse(item.Subject); err != nil {
+			return errors.Wrap(err, "invalid mailConfig.templates.subject of "+item.Name)
+		}
+		if _, err := template.New("body").Parse(item.Body); err != nil {
+			return errors.Wrap(err, "invalid mailConfig.templates.body of "+item.Name)
+		}
+	}
+
+	return nil
+}
+
+func validateRule(rule *LintRule) error {
+	if rule.Name == "" {
+		return errors.New("invalid name")
+	}
+
+	for _, ext := range rule.Extensions {
+		if !strings.HasPrefix(ext, extensionSep) {
+			return errors.New("invalid extensions " + ext)
+		}
+	}
+
+	if rule.SubjectMin < 0 || rule.SubjectMax < 0 || rule.DescriptionMax < 0 || rule.SizeMax < 0 || rule.Tokens < 0 {
+		return errors.New("invalid limits of " + rule.Name)
+	}
+
+	if rule.SubjectMax > 0 && rule.SubjectMin > rule.SubjectMax {
+		return errors.New("invalid subjectMin of " + rule.Name)
+	}
+
+	if s := rule.SubjectStyle; s != "" && s != StyleConventional && s != StyleModule {
+		return errors.New("invalid subjectStyle " + s)
+	}
+
+	for _, item := range rule.Trailers {
+		if item == "" || strings.ContainsAny(item, ": ") {
+			return errors.New("invalid trailers " + item)
+		}
+	}
+
+	for _, item := range append(slices.Clone(rule.Allowlist.Paths), rule.Allowlist.Regexes...) {
+		if _, err := regexp.Compile(item); err != nil {
+			return errors.Wrap(err, "invalid allowlist "+item)
+		}
+	}
+
+	for _, ext := range rule.Denylist {
+		if !strings.HasPrefix(ext, extensionSep) {
+			return errors.New("invalid denylist " + ext)
+		}
+	}
+
+	if rule.License != "" && !licenseExpr.MatchString(rule.License) {
+		return errors.New("invalid license " + rule.License)
+	}
+
+	if _, err := template.New("header").Parse(rule.Header); err != nil {
+		return errors.Wrap(err, "invalid header of "+rule.Name)
+	}
+
+	if _, err := template.New("prompt").Parse(rule.Prompt); err != nil {
+		return errors.Wrap(err, "invalid prompt of "+rule.Name)
+	}
+
+	return nil
+}
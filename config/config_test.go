@@ -1,12 +1,191 @@
 package config
 
 import (
+	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
 )
 
 func TestConfig(t *testing.T) {
 	cfg := New()
 	assert.NotEqual(t, nil, cfg)
 }
+
+func TestConfigYaml(t *testing.T) {
+	buf, err := os.ReadFile("../test/config/config.yml")
+	assert.Equal(t, nil, err)
+
+	cfg := New()
+
+	err = yaml.Unmarshal(buf, cfg)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "10s", cfg.Spec.CodeConfig.Duration)
+	assert.Equal(t, 5, len(cfg.Spec.CodeConfig.LintConfigs))
+	assert.Equal(t, "kernellinter", cfg.Spec.CodeConfig.LintConfigs[0].Name)
+	assert.Equal(t, 5, len(cfg.Spec.CodeConfig.LintConfigs[0].Extensions))
+	assert.Equal(t, 2, len(cfg.Spec.CodeConfig.LintConfigs[1].Rules))
+	assert.Equal(t, int64(50), cfg.Spec.CodeConfig.LintConfigs[1].Rules[0].SubjectMax)
+	assert.Equal(t, StyleModule, cfg.Spec.CodeConfig.LintConfigs[1].Rules[0].SubjectStyle)
+	assert.Equal(t, []string{"Signed-off-by"}, cfg.Spec.CodeConfig.LintConfigs[1].Rules[0].Trailers)
+	assert.Equal(t, true, cfg.Spec.CodeConfig.LintConfigs[1].Rules[1].Disabled)
+	assert.Equal(t, []string{`^test/`}, cfg.Spec.CodeConfig.LintConfigs[2].Rules[0].Allowlist.Paths)
+	assert.Equal(t, []string{`^changeit$`}, cfg.Spec.CodeConfig.LintConfigs[2].Rules[0].Allowlist.Regexes)
+	assert.Equal(t, "Apache-2.0", cfg.Spec.CodeConfig.LintConfigs[3].Rules[0].License)
+	assert.Equal(t, int64(4000), cfg.Spec.CodeConfig.LintConfigs[4].Rules[0].Tokens)
+	assert.Equal(t, "+1", cfg.Spec.CodeConfig.LintVote.Approval)
+	assert.Equal(t, "-1", cfg.Spec.CodeConfig.LintVote.Disapproval)
+	assert.Equal(t, "Code-Review", cfg.Spec.CodeConfig.LintVote.Label)
+	assert.Equal(t, "oxsecurity/megalinter-cupcake", cfg.Spec.CodeConfig.MegaConfig.Image)
+	assert.Equal(t, PullIfNotPresent, cfg.Spec.CodeConfig.MegaConfig.PullPolicy)
+
+	err = cfg.Validate()
+	assert.Equal(t, nil, err)
+}
+
+func TestConfigValidate(t *testing.T) {
+	cfg := New()
+
+	err := cfg.Validate()
+	assert.Equal(t, nil, err)
+
+	cfg.Spec.CodeConfig.Duration = "10"
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.CodeConfig.Duration = "-10s"
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.CodeConfig.Duration = "10s"
+	cfg.Spec.CodeConfig.LintConfigs = []LintConfig{{Name: ""}}
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.CodeConfig.LintConfigs = []LintConfig{{Name: "kernellinter", Extensions: []string{"c"}}}
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.CodeConfig.LintConfigs = []LintConfig{{Name: "commitlinter", Rules: []LintRule{{Name: ""}}}}
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.CodeConfig.LintConfigs = []LintConfig{{Name: "commitlinter", Rules: []LintRule{{Name: "json", Extensions: []string{"json"}}}}}
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.CodeConfig.LintConfigs = []LintConfig{{Name: "commitlinter", Rules: []LintRule{{Name: "message", SubjectMin: 50, SubjectMax: 10}}}}
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.CodeConfig.LintConfigs = []LintConfig{{Name: "commitlinter", Rules: []LintRule{{Name: "message", DescriptionMax: -1}}}}
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.CodeConfig.LintConfigs = []LintConfig{{Name: "commitlinter", Rules: []LintRule{{Name: "message", SubjectStyle: "angular"}}}}
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.CodeConfig.LintConfigs = []LintConfig{{Name: "commitlinter", Rules: []LintRule{{Name: "message", Trailers: []string{"Bug:"}}}}}
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.CodeConfig.LintConfigs = []LintConfig{{Name: "commitlinter", Rules: []LintRule{{Name: "file", SizeMax: -1}}}}
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.CodeConfig.LintConfigs = []LintConfig{{Name: "commitlinter", Rules: []LintRule{{Name: "file", Denylist: []string{"apk"}}}}}
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.CodeConfig.LintConfigs = []LintConfig{{Name: "secretlinter", Rules: []LintRule{
+		{Name: "password", Allowlist: LintAllowlist{Regexes: []string{"[a-"}}},
+	}}}
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.CodeConfig.LintConfigs = []LintConfig{{Name: "licenselinter", Rules: []LintRule{{Name: "slash", License: "MIT/X11"}}}}
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.CodeConfig.LintConfigs = []LintConfig{{Name: "licenselinter", Rules: []LintRule{{Name: "slash", Header: "{{.License"}}}}
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.CodeConfig.LintConfigs = []LintConfig{{Name: "gptlinter", Rules: []LintRule{{Name: "review", Prompt: "{{.File"}}}}
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.CodeConfig.LintConfigs = []LintConfig{{Name: "gptlinter", Rules: []LintRule{{Name: "review", Tokens: -1}}}}
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.CodeConfig.LintConfigs = []LintConfig{{Name: "commitlinter", Rules: []LintRule{{Name: "message", SubjectMin: 10,
+		SubjectStyle: StyleConventional, Trailers: []string{"Signed-off-by"}}}}}
+	err = cfg.Validate()
+	assert.Equal(t, nil, err)
+
+	cfg.Spec.CodeConfig.LintConfigs = []LintConfig{{Name: "kernellinter", Extensions: []string{".c"}}}
+	cfg.Spec.CodeConfig.LintVote = LintVote{Approval: "+1", Disapproval: "minus"}
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.CodeConfig.LintVote = LintVote{Approval: "+1", Disapproval: "-1"}
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.CodeConfig.LintVote.Label = "Code-Review"
+	err = cfg.Validate()
+	assert.Equal(t, nil, err)
+
+	cfg.Spec.CodeConfig.LintVote.Labels = []LintLabel{{Name: "Lint-Check", Approval: "0", Disapproval: "minus"}}
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.CodeConfig.LintVote.Labels = []LintLabel{{Approval: "0"}}
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.CodeConfig.LintVote = LintVote{Approval: "+1", Labels: []LintLabel{{Name: "Lint-Check"}}}
+	err = cfg.Validate()
+	assert.Equal(t, nil, err)
+
+	cfg.Spec.CodeConfig.MegaConfig = MegaConfig{Digest: "0123456789"}
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.CodeConfig.MegaConfig = MegaConfig{PullPolicy: "missing"}
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.CodeConfig.MegaConfig = MegaConfig{Digest: "sha256:0123456789", PullPolicy: PullNever}
+	err = cfg.Validate()
+	assert.Equal(t, nil, err)
+
+	cfg.Spec.BuildConfig.LoggingConfig.Len = -1
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.BuildConfig.LoggingConfig.Len = 1
+	cfg.Spec.MailConfig.ContentType = "text/xml"
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.MailConfig.ContentType = "text/html"
+	cfg.Spec.MailConfig.Templates = []MailTemplate{{Name: "nodesight", Subject: "{{.Title}}", Body: "<p>{{.Data.Error}}</p>"}}
+	err = cfg.Validate()
+	assert.Equal(t, nil, err)
+
+	cfg.Spec.MailConfig.Templates = []MailTemplate{{Name: "sight"}}
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.MailConfig.Templates = []MailTemplate{{Name: "nodesight", Subject: "{{.Title"}}
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+
+	cfg.Spec.MailConfig.Templates = []MailTemplate{{Name: "buildsight", Body: "{{range .Data.LoggingInfos}}"}}
+	err = cfg.Validate()
+	assert.NotEqual(t, nil, err)
+}
@@ -0,0 +1,252 @@
+package config
+
+import (
+	"reflect"
+	"slices"
+
+	"github.com/devops-pipeflow/insight-plugin/proto"
+)
+
+// FromProto sets the settings carried by proto.Config (e.g., Config RPC) into the config,
+// each section set (e.g., codeConfig) replaces the one in the config in full, the sections
+// unset (zero) and the others (e.g., sshConfig, mailConfig) are kept.
+func FromProto(cfg *Config, req *proto.Config) {
+	if !isZero(req.BuildConfig) {
+		fromBuild(cfg, req)
+	}
+
+	if !isZero(req.CodeConfig) {
+		fromCode(cfg, req)
+	}
+
+	if !isZero(req.NodeConfig) {
+		cfg.Spec.NodeConfig = NodeConfig{
+			Duration: req.NodeConfig.Duration,
+		}
+	}
+
+	if !isZero(req.ArtifactConfig) {
+		cfg.Spec.ArtifactConfig = ArtifactConfig{
+			Url:  req.ArtifactConfig.Url,
+			User: req.ArtifactConfig.User,
+			Pass: req.ArtifactConfig.Pass,
+		}
+	}
+
+	if !isZero(req.GptConfig) {
+		cfg.Spec.GptConfig = GptConfig{
+			Url:  req.GptConfig.Url,
+			User: req.GptConfig.User,
+			Pass: req.GptConfig.Pass,
+		}
+	}
+
+	if !isZero(req.RepoConfig) {
+		cfg.Spec.RepoConfig = RepoConfig{
+			Url:  req.RepoConfig.Url,
+			User: req.RepoConfig.User,
+			Pass: req.RepoConfig.Pass,
+		}
+	}
+
+	if !isZero(req.ReviewConfig) {
+		cfg.Spec.ReviewConfig = ReviewConfig{
+			Url:  req.ReviewConfig.Url,
+			User: req.ReviewConfig.User,
+			Pass: req.ReviewConfig.Pass,
+		}
+	}
+}
+
+func fromBuild(cfg *Config, req *proto.Config) {
+	cfg.Spec.BuildConfig = BuildConfig{
+		Duration: req.BuildConfig.Duration,
+		LoggingConfig: LoggingConfig{
+			Start: req.BuildConfig.LoggingConfig.Start,
+			Len:   req.BuildConfig.LoggingConfig.Len,
+			Count: req.BuildConfig.LoggingConfig.Count,
+		},
+	}
+}
+
+func fromCode(cfg *Config, req *proto.Config) {
+	cfg.Spec.CodeConfig = CodeConfig{
+		Duration:    req.CodeConfig.Duration,
+		LintConfigs: make([]LintConfig, 0, len(req.CodeConfig.LintConfigs)),
+		LintVote: LintVote{
+			Approval:    req.CodeConfig.LintVote.Approval,
+			Disapproval: req.CodeConfig.LintVote.Disapproval,
+			Label:       req.CodeConfig.LintVote.Label,
+			Message:     req.CodeConfig.LintVote.Message,
+			CommentOnly: req.CodeConfig.LintVote.CommentOnly,
+		},
+		MegaConfig: MegaConfig{
+			Registry:   req.CodeConfig.MegaConfig.Registry,
+			Image:      req.CodeConfig.MegaConfig.Image,
+			Tag:        req.CodeConfig.MegaConfig.Tag,
+			Digest:     req.CodeConfig.MegaConfig.Digest,
+			PullPolicy: req.CodeConfig.MegaConfig.PullPolicy,
+		},
+	}
+
+	for _, item := range req.CodeConfig.LintVote.Labels {
+		cfg.Spec.CodeConfig.LintVote.Labels = append(cfg.Spec.CodeConfig.LintVote.Labels, LintLabel{
+			Name:        item.Name,
+			Approval:    item.Approval,
+			Disapproval: item.Disapproval,
+		})
+	}
+
+	for _, item := range req.CodeConfig.LintConfigs {
+		cfg.Spec.CodeConfig.LintConfigs = append(cfg.Spec.CodeConfig.LintConfigs, LintConfig{
+			Name:       item.Name,
+			Extensions: slices.Clone(item.Extensions),
+			Files:      slices.Clone(item.Files),
+			Projects:   slices.Clone(item.Projects),
+			Rules:      fromRules(item.Rules),
+		})
+	}
+}
+
+// ToProto returns the settings of the config carried by proto.Config.
+func ToProto(cfg *Config) *proto.Config {
+	req := &proto.Config{
+		BuildConfig: proto.BuildConfig{
+			LoggingConfig: proto.LoggingConfig{
+				Start: cfg.Spec.BuildConfig.LoggingConfig.Start,
+				Len:   cfg.Spec.BuildConfig.LoggingConfig.Len,
+				Count: cfg.Spec.BuildConfig.LoggingConfig.Count,
+			},
+			Duration: cfg.Spec.BuildConfig.Duration,
+		},
+		CodeConfig: proto.CodeConfig{
+			Duration:    cfg.Spec.CodeConfig.Duration,
+			LintConfigs: make([]proto.LintConfig, 0, len(cfg.Spec.CodeConfig.LintConfigs)),
+			LintVote: proto.LintVote{
+				Approval:    cfg.Spec.CodeConfig.LintVote.Approval,
+				Disapproval: cfg.Spec.CodeConfig.LintVote.Disapproval,
+				Label:       cfg.Spec.CodeConfig.LintVote.Label,
+				Message:     cfg.Spec.CodeConfig.LintVote.Message,
+				CommentOnly: cfg.Spec.CodeConfig.LintVote.CommentOnly,
+			},
+			MegaConfig: proto.MegaConfig{
+				Registry:   cfg.Spec.CodeConfig.MegaConfig.Registry,
+				Image:      cfg.Spec.CodeConfig.MegaConfig.Image,
+				Tag:        cfg.Spec.CodeConfig.MegaConfig.Tag,
+				Digest:     cfg.Spec.CodeConfig.MegaConfig.Digest,
+				PullPolicy: cfg.Spec.CodeConfig.MegaConfig.PullPolicy,
+			},
+		},
+		NodeConfig: proto.NodeConfig{
+			Duration: cfg.Spec.NodeConfig.Duration,
+		},
+		ArtifactConfig: proto.ArtifactConfig{
+			Url:  cfg.Spec.ArtifactConfig.Url,
+			User: cfg.Spec.ArtifactConfig.User,
+			Pass: cfg.Spec.ArtifactConfig.Pass,
+		},
+		GptConfig: proto.GptConfig{
+			Url:  cfg.Spec.GptConfig.Url,
+			User: cfg.Spec.GptConfig.User,
+			Pass: cfg.Spec.GptConfig.Pass,
+		},
+		RepoConfig: proto.RepoConfig{
+			Url:  cfg.Spec.RepoConfig.Url,
+			User: cfg.Spec.RepoConfig.User,
+			Pass: cfg.Spec.RepoConfig.Pass,
+		},
+		ReviewConfig: proto.ReviewConfig{
+			Url:  cfg.Spec.ReviewConfig.Url,
+			User: cfg.Spec.ReviewConfig.User,
+			Pass: cfg.Spec.ReviewConfig.Pass,
+		},
+	}
+
+	for _, item := range cfg.Spec.CodeConfig.LintConfigs {
+		req.CodeConfig.LintConfigs = append(req.CodeConfig.LintConfigs, proto.LintConfig{
+			Name:       item.Name,
+			Extensions: slices.Clone(item.Extensions),
+			Files:      slices.Clone(item.Files),
+			Projects:   slices.Clone(item.Projects),
+			Rules:      toRules(item.Rules),
+		})
+	}
+
+	for _, item := range cfg.Spec.CodeConfig.LintVote.Labels {
+		req.CodeConfig.LintVote.Labels = append(req.CodeConfig.LintVote.Labels, proto.LintLabel{
+			Name:        item.Name,
+			Approval:    item.Approval,
+			Disapproval: item.Disapproval,
+		})
+	}
+
+	return req
+}
+
+func fromRules(rules []proto.LintRule) []LintRule {
+	var buf []LintRule
+
+	for _, item := range rules {
+		buf = append(buf, LintRule{
+			Name:           item.Name,
+			Disabled:       item.Disabled,
+			Extensions:     slices.Clone(item.Extensions),
+			Files:          slices.Clone(item.Files),
+			SubjectMin:     item.SubjectMin,
+			SubjectMax:     item.SubjectMax,
+			DescriptionMax: item.DescriptionMax,
+			SubjectStyle:   item.SubjectStyle,
+			Trailers:       slices.Clone(item.Trailers),
+			Allowlist: LintAllowlist{
+				Paths:   slices.Clone(item.Allowlist.Paths),
+				Regexes: slices.Clone(item.Allowlist.Regexes),
+			},
+			License:      item.License,
+			Copyright:    item.Copyright,
+			Header:       item.Header,
+			SizeMax:      item.SizeMax,
+			Denylist:     slices.Clone(item.Denylist),
+			IllegalChars: item.IllegalChars,
+			Prompt:       item.Prompt,
+			Tokens:       item.Tokens,
+		})
+	}
+
+	return buf
+}
+
+func toRules(rules []LintRule) []proto.LintRule {
+	var buf []proto.LintRule
+
+	for _, item := range rules {
+		buf = append(buf, proto.LintRule{
+			Name:           item.Name,
+			Disabled:       item.Disabled,
+			Extensions:     slices.Clone(item.Extensions),
+			Files:          slices.Clone(item.Files),
+			SubjectMin:     item.SubjectMin,
+			SubjectMax:     item.SubjectMax,
+			DescriptionMax: item.DescriptionMax,
+			SubjectStyle:   item.SubjectStyle,
+			Trailers:       slices.Clone(item.Trailers),
+			Allowlist: proto.LintAllowlist{
+				Paths:   slices.Clone(item.Allowlist.Paths),
+				Regexes: slices.Clone(item.Allowlist.Regexes),
+			},
+			License:      item.License,
+			Copyright:    item.Copyright,
+			Header:       item.Header,
+			SizeMax:      item.SizeMax,
+			Denylist:     slices.Clone(item.Denylist),
+			IllegalChars: item.IllegalChars,
+			Prompt:       item.Prompt,
+			Tokens:       item.Tokens,
+		})
+	}
+
+	return buf
+}
+
+func isZero(data interface{}) bool {
+	return reflect.ValueOf(data).IsZero()
+}
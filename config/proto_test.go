@@ -0,0 +1,82 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/devops-pipeflow/insight-plugin/proto"
+)
+
+func TestFromProto(t *testing.T) {
+	cfg := New()
+	cfg.Spec.SshConfig.Timeout = "10s"
+	cfg.Spec.ReviewConfig = ReviewConfig{Url: "127.0.0.1:8080", User: "user", Pass: "pass"}
+
+	req := &proto.Config{
+		CodeConfig: proto.CodeConfig{
+			Duration: "10s",
+			LintConfigs: []proto.LintConfig{
+				{Name: "kernellinter", Extensions: []string{".c"}, Projects: []string{"kernel"}},
+			},
+			LintVote: proto.LintVote{
+				Approval:    "+1",
+				Disapproval: "-1",
+				Label:       "Code-Review",
+				Labels:      []proto.LintLabel{{Name: "Lint-Check"}},
+				CommentOnly: true,
+			},
+		},
+		GptConfig: proto.GptConfig{Url: "127.0.0.1:8081"},
+	}
+
+	FromProto(cfg, req)
+	assert.Equal(t, "10s", cfg.Spec.CodeConfig.Duration)
+	assert.Equal(t, 1, len(cfg.Spec.CodeConfig.LintConfigs))
+	assert.Equal(t, []string{".c"}, cfg.Spec.CodeConfig.LintConfigs[0].Extensions)
+	assert.Equal(t, "Code-Review", cfg.Spec.CodeConfig.LintVote.Label)
+	assert.Equal(t, "Lint-Check", cfg.Spec.CodeConfig.LintVote.Labels[0].Name)
+	assert.Equal(t, true, cfg.Spec.CodeConfig.LintVote.CommentOnly)
+	assert.Equal(t, "127.0.0.1:8081", cfg.Spec.GptConfig.Url)
+	assert.Equal(t, "10s", cfg.Spec.SshConfig.Timeout)
+	assert.Equal(t, "pass", cfg.Spec.ReviewConfig.Pass)
+
+	req.CodeConfig.LintConfigs[0].Extensions[0] = ".h"
+	assert.Equal(t, ".c", cfg.Spec.CodeConfig.LintConfigs[0].Extensions[0])
+
+	req.CodeConfig.LintConfigs[0].Projects[0] = "platform"
+	assert.Equal(t, "kernel", cfg.Spec.CodeConfig.LintConfigs[0].Projects[0])
+}
+
+func TestToProto(t *testing.T) {
+	cfg := New()
+	cfg.Spec.BuildConfig.Duration = "10m"
+	cfg.Spec.BuildConfig.LoggingConfig = LoggingConfig{Start: 1, Len: 2, Count: 3}
+	cfg.Spec.CodeConfig.LintConfigs = []LintConfig{
+		{Name: "commitlinter", Rules: []LintRule{{Name: "message", SubjectMax: 50, Trailers: []string{"Bug"}}}},
+		{Name: "secretlinter", Rules: []LintRule{
+			{Name: "password", Allowlist: LintAllowlist{Paths: []string{`^test/`}, Regexes: []string{`^changeit$`}}},
+		}},
+		{Name: "gptlinter", Rules: []LintRule{{Name: "review", Prompt: "Review {{.File}}", Tokens: 4000}}},
+	}
+	cfg.Spec.CodeConfig.LintVote = LintVote{Labels: []LintLabel{{Name: "Verified", Approval: "+1"}}}
+	cfg.Spec.CodeConfig.MegaConfig = MegaConfig{Image: "oxsecurity/megalinter-go", Tag: "v7", PullPolicy: PullNever}
+	cfg.Spec.NodeConfig.Duration = "10s"
+
+	req := ToProto(cfg)
+	assert.Equal(t, "10m", req.BuildConfig.Duration)
+	assert.Equal(t, int64(3), req.BuildConfig.LoggingConfig.Count)
+	assert.Equal(t, "commitlinter", req.CodeConfig.LintConfigs[0].Name)
+	assert.Equal(t, int64(50), req.CodeConfig.LintConfigs[0].Rules[0].SubjectMax)
+	assert.Equal(t, []string{`^test/`}, req.CodeConfig.LintConfigs[1].Rules[0].Allowlist.Paths)
+	assert.Equal(t, []string{`^changeit$`}, req.CodeConfig.LintConfigs[1].Rules[0].Allowlist.Regexes)
+	assert.Equal(t, int64(4000), req.CodeConfig.LintConfigs[2].Rules[0].Tokens)
+	assert.Equal(t, "oxsecurity/megalinter-go", req.CodeConfig.MegaConfig.Image)
+	assert.Equal(t, "10s", req.NodeConfig.Duration)
+
+	c := New()
+	FromProto(c, req)
+	assert.Equal(t, cfg.Spec.BuildConfig, c.Spec.BuildConfig)
+	assert.Equal(t, cfg.Spec.CodeConfig, c.Spec.CodeConfig)
+	assert.Equal(t, cfg.Spec.NodeConfig, c.Spec.NodeConfig)
+}
@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
 
@@ -12,6 +13,9 @@ func main() {
 	ctx := context.Background()
 
 	if err := cmd.Run(ctx); err != nil {
+		if errors.Is(err, cmd.ErrTrigger) {
+			os.Exit(2)
+		}
 		fmt.Println(err.Error())
 		os.Exit(1)
 	}
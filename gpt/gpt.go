@@ -87,7 +87,7 @@ func (g *gpt) Run(ctx context.Context, content string) (string, error) {
 	return g.sendRequest(ctx, content)
 }
 
-func (g *gpt) sendRequest(_ context.Context, content string) (string, error) {
+func (g *gpt) sendRequest(ctx context.Context, content string) (string, error) {
 	g.cfg.Logger.Debug("gpt: sendRequest")
 
 	var buf Response
@@ -98,7 +98,7 @@ func (g *gpt) sendRequest(_ context.Context, content string) (string, error) {
 		return "", errors.Wrap(err, "failed to marshal request")
 	}
 
-	req, _ := http.NewRequest("POST", g.api, bytes.NewBuffer(marshal))
+	req, _ := http.NewRequestWithContext(ctx, "POST", g.api, bytes.NewBuffer(marshal))
 	req.Header.Set("content-type", "application/json")
 
 	client := &http.Client{
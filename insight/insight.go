@@ -2,17 +2,28 @@ package insight
 
 import (
 	"context"
+	"slices"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/go-hclog"
 	"github.com/pkg/errors"
 	"golang.org/x/sync/errgroup"
 
 	"github.com/devops-pipeflow/insight-plugin/config"
+	"github.com/devops-pipeflow/insight-plugin/mail"
 	"github.com/devops-pipeflow/insight-plugin/proto"
 	"github.com/devops-pipeflow/insight-plugin/sights"
 )
 
 const (
+	bodySep     = "\n\n"
+	bodySepHtml = "\n<hr>\n"
+	errorSep    = "; "
+	subjectSep  = "; "
+
+	durationDefault = 10 * time.Minute
+
 	routineNum = -1
 )
 
@@ -81,19 +92,26 @@ func (i *insight) Run(ctx context.Context,
 	var (
 		buildInfo proto.BuildInfo
 		codeInfo  proto.CodeInfo
-		mailInfo  proto.MailInfo
 		nodeInfo  proto.NodeInfo
-		err       error
 	)
 
+	var buildMail, codeMail, nodeMail proto.MailInfo
+
 	g, ctx := errgroup.WithContext(ctx)
 	g.SetLimit(routineNum)
 
+	// Each sight runs to completion with its own timeout, its error is reported in its info
+	// instead of canceling the others.
 	g.Go(func() error {
 		if buildTrigger != nil {
-			buildInfo, mailInfo, err = i.cfg.BuildSight.Run(ctx, buildTrigger)
+			var err error
+			buildInfo, buildMail, err = runSight(ctx, i.cfg.Config.Spec.BuildConfig.Duration,
+				func(ctx context.Context) (proto.BuildInfo, proto.MailInfo, error) {
+					return i.cfg.BuildSight.Run(ctx, buildTrigger)
+				})
 			if err != nil {
-				return errors.Wrap(err, "failed to run buildsight")
+				i.cfg.Logger.Error("insight: failed to run buildsight: " + err.Error())
+				buildInfo.Error = mergeError(buildInfo.Error, err)
 			}
 		}
 		return nil
@@ -101,9 +119,14 @@ func (i *insight) Run(ctx context.Context,
 
 	g.Go(func() error {
 		if codeTrigger != nil {
-			codeInfo, mailInfo, err = i.cfg.CodeSight.Run(ctx, codeTrigger)
+			var err error
+			codeInfo, codeMail, err = runSight(ctx, i.cfg.Config.Spec.CodeConfig.Duration,
+				func(ctx context.Context) (proto.CodeInfo, proto.MailInfo, error) {
+					return i.cfg.CodeSight.Run(ctx, codeTrigger)
+				})
 			if err != nil {
-				return errors.Wrap(err, "failed to run codesight")
+				i.cfg.Logger.Error("insight: failed to run codesight: " + err.Error())
+				codeInfo.Error = mergeError(codeInfo.Error, err)
 			}
 		}
 		return nil
@@ -111,17 +134,138 @@ func (i *insight) Run(ctx context.Context,
 
 	g.Go(func() error {
 		if nodeTrigger != nil {
-			nodeInfo, mailInfo, err = i.cfg.NodeSight.Run(ctx, nodeTrigger)
+			var err error
+			nodeInfo, nodeMail, err = runSight(ctx, i.cfg.Config.Spec.NodeConfig.Duration,
+				func(ctx context.Context) (proto.NodeInfo, proto.MailInfo, error) {
+					return i.cfg.NodeSight.Run(ctx, nodeTrigger)
+				})
 			if err != nil {
-				return errors.Wrap(err, "failed to run nodesight")
+				i.cfg.Logger.Error("insight: failed to run nodesight: " + err.Error())
+				nodeInfo.Error = mergeError(nodeInfo.Error, err)
 			}
 		}
 		return nil
 	})
 
-	if err = g.Wait(); err != nil {
-		return buildInfo, codeInfo, mailInfo, nodeInfo, errors.Wrap(err, "failed to wait routine")
-	}
+	_ = g.Wait()
+
+	mailInfo := mergeMail(buildMail, codeMail, nodeMail)
 
 	return buildInfo, codeInfo, mailInfo, nodeInfo, nil
 }
+
+// runSight runs the sight with the timeout, the sight is cancelled once timed out and left to
+// exit by itself, so that a sight ignoring the context (e.g., hung command) does not block Run.
+// The zero info is returned with the timeout error, the sight must not act (e.g., vote on
+// review) once its context is done.
+func runSight[T any](ctx context.Context, duration string, run func(context.Context) (T, proto.MailInfo, error)) (
+	T, proto.MailInfo, error) {
+	type result struct {
+		info T
+		mail proto.MailInfo
+		err  error
+	}
+
+	var info T
+
+	timeout := durationDefault
+
+	if duration != "" {
+		var err error
+		timeout, err = time.ParseDuration(duration)
+		if err != nil {
+			return info, proto.MailInfo{}, errors.Wrap(err, "failed to parse duration")
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ch := make(chan result, 1)
+
+	go func() {
+		i, m, e := run(ctx)
+		ch <- result{info: i, mail: m, err: e}
+	}()
+
+	select {
+	case r := <-ch:
+		return r.info, r.mail, r.err
+	case <-ctx.Done():
+		return info, proto.MailInfo{}, errors.Wrap(ctx.Err(), "failed to wait sight")
+	}
+}
+
+// mergeError appends the error to the one reported by the sight unless already included.
+func mergeError(data string, err error) string {
+	if data == "" {
+		return err.Error()
+	}
+
+	if strings.Contains(err.Error(), data) {
+		return err.Error()
+	}
+
+	return data + errorSep + err.Error()
+}
+
+// mergeMail combines the mails of sights into one notification, the subjects and bodies
+// are joined in order, the addresses and attachments are merged without duplicates. The
+// notification is in html if any mail is, the plain bodies are converted into html.
+func mergeMail(mails ...proto.MailInfo) proto.MailInfo {
+	var buf proto.MailInfo
+	var subjects, bodies []string
+
+	for _, item := range mails {
+		if item.Subject == "" && item.Body == "" {
+			continue
+		}
+		if buf.ContentType == "" || item.ContentType == mail.ContentTypeHtml {
+			buf.ContentType = item.ContentType
+		}
+	}
+
+	sep := bodySep
+	if buf.ContentType == mail.ContentTypeHtml {
+		sep = bodySepHtml
+	}
+
+	for _, item := range mails {
+		if item.Subject == "" && item.Body == "" {
+			continue
+		}
+		if buf.FromAddress == "" {
+			buf.FromAddress = item.FromAddress
+		}
+		if item.Subject != "" {
+			subjects = append(subjects, item.Subject)
+		}
+		if item.Body != "" && buf.ContentType == mail.ContentTypeHtml && item.ContentType != mail.ContentTypeHtml {
+			bodies = append(bodies, mail.HtmlBody(item.Body))
+		} else if item.Body != "" {
+			bodies = append(bodies, item.Body)
+		}
+		buf.ToAddresses = mergeList(buf.ToAddresses, item.ToAddresses)
+		buf.CcAddresses = mergeList(buf.CcAddresses, item.CcAddresses)
+		buf.Attachments = mergeList(buf.Attachments, item.Attachments)
+	}
+
+	buf.CcAddresses = slices.DeleteFunc(buf.CcAddresses, func(data string) bool {
+		return slices.Contains(buf.ToAddresses, data)
+	})
+
+	buf.Subject = strings.Join(subjects, subjectSep)
+	buf.Body = strings.Join(bodies, sep)
+
+	return buf
+}
+
+func mergeList(dst, src []string) []string {
+	for _, item := range src {
+		if !slices.Contains(dst, item) {
+			dst = append(dst, item)
+		}
+	}
+
+	return dst
+}
@@ -1,17 +1,225 @@
 package insight
 
 import (
+	"context"
+	"strings"
 	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/devops-pipeflow/insight-plugin/config"
+	"github.com/devops-pipeflow/insight-plugin/mail"
+	"github.com/devops-pipeflow/insight-plugin/proto"
 )
 
+type fakeBuildSight struct {
+	mail proto.MailInfo
+	err  error
+}
+
+func (s *fakeBuildSight) Init(_ context.Context) error {
+	return nil
+}
+
+func (s *fakeBuildSight) Deinit(_ context.Context) error {
+	return nil
+}
+
+func (s *fakeBuildSight) Run(_ context.Context, _ *proto.BuildTrigger) (proto.BuildInfo, proto.MailInfo, error) {
+	time.Sleep(time.Millisecond)
+	return proto.BuildInfo{RootCause: "build"}, s.mail, s.err
+}
+
+type fakeCodeSight struct {
+	mail proto.MailInfo
+	err  error
+}
+
+func (s *fakeCodeSight) Init(_ context.Context) error {
+	return nil
+}
+
+func (s *fakeCodeSight) Deinit(_ context.Context) error {
+	return nil
+}
+
+func (s *fakeCodeSight) Run(_ context.Context, _ *proto.CodeTrigger) (proto.CodeInfo, proto.MailInfo, error) {
+	return proto.CodeInfo{}, s.mail, s.err
+}
+
+type fakeNodeSight struct {
+	mail      proto.MailInfo
+	err       error
+	delay     time.Duration
+	ignore    bool
+	cancelled bool
+	done      chan struct{}
+}
+
+func (s *fakeNodeSight) Init(_ context.Context) error {
+	return nil
+}
+
+func (s *fakeNodeSight) Deinit(_ context.Context) error {
+	return nil
+}
+
+func (s *fakeNodeSight) Run(ctx context.Context, _ *proto.NodeTrigger) (proto.NodeInfo, proto.MailInfo, error) {
+	if s.done != nil {
+		defer close(s.done)
+	}
+	if s.ignore {
+		time.Sleep(s.delay)
+		return proto.NodeInfo{Error: "node"}, s.mail, s.err
+	}
+	select {
+	case <-time.After(s.delay):
+	case <-ctx.Done():
+		s.cancelled = true
+	}
+	return proto.NodeInfo{Error: "node"}, s.mail, s.err
+}
+
+func initInsight() insight {
+	i := insight{
+		cfg: DefaultConfig(),
+	}
+
+	i.cfg.Config = config.Config{}
+	i.cfg.Logger = hclog.New(&hclog.LoggerOptions{
+		Name:  "insight",
+		Level: hclog.LevelFromString("INFO"),
+	})
+
+	i.cfg.BuildSight = &fakeBuildSight{
+		mail: proto.MailInfo{
+			ContentType: "text/plain",
+			FromAddress: "pipeflow",
+			ToAddresses: []string{"owner@example.com"},
+			CcAddresses: []string{"author@example.com"},
+			Subject:     "[buildsight]: build failed",
+			Body:        "build failed",
+			Attachments: []string{"/tmp/errors.txt"},
+		},
+	}
+
+	i.cfg.CodeSight = &fakeCodeSight{}
+
+	i.cfg.NodeSight = &fakeNodeSight{
+		mail: proto.MailInfo{
+			ContentType: "text/plain",
+			FromAddress: "pipeflow",
+			ToAddresses: []string{"author@example.com"},
+			Subject:     "[nodesight]: node unhealthy",
+			Body:        "node unhealthy",
+			Attachments: []string{"/tmp/nodestat.json"},
+		},
+	}
+
+	return i
+}
+
 func TestInsightInit(t *testing.T) {
-	t.Skip("Skipping TestInsightInit.")
+	ctx := context.Background()
+	i := initInsight()
+
+	err := i.Init(ctx)
+	assert.Equal(t, nil, err)
 }
 
 func TestInsightDeinit(t *testing.T) {
-	t.Skip("Skipping TestInsightDeinit.")
+	ctx := context.Background()
+	i := initInsight()
+
+	err := i.Deinit(ctx)
+	assert.Equal(t, nil, err)
 }
 
 func TestInsightRun(t *testing.T) {
-	t.Skip("Skipping TestInsightRun.")
+	ctx := context.Background()
+	i := initInsight()
+
+	buildInfo, _, mailInfo, nodeInfo, err := i.Run(ctx, &proto.BuildTrigger{}, &proto.CodeTrigger{}, &proto.NodeTrigger{})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "build", buildInfo.RootCause)
+	assert.Equal(t, "node", nodeInfo.Error)
+	assert.Equal(t, "[buildsight]: build failed; [nodesight]: node unhealthy", mailInfo.Subject)
+	assert.Equal(t, "build failed"+bodySep+"node unhealthy", mailInfo.Body)
+	assert.Equal(t, []string{"owner@example.com", "author@example.com"}, mailInfo.ToAddresses)
+	assert.Equal(t, 0, len(mailInfo.CcAddresses))
+	assert.Equal(t, 2, len(mailInfo.Attachments))
+
+	_, _, mailInfo, _, err = i.Run(ctx, nil, nil, &proto.NodeTrigger{})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "[nodesight]: node unhealthy", mailInfo.Subject)
+
+	i.cfg.CodeSight = &fakeCodeSight{err: errors.New("code")}
+	i.cfg.NodeSight = &fakeNodeSight{err: errors.New("ssh unreachable")}
+
+	buildInfo, codeInfo, mailInfo, nodeInfo, err := i.Run(ctx, &proto.BuildTrigger{}, &proto.CodeTrigger{}, &proto.NodeTrigger{})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "build", buildInfo.RootCause)
+	assert.Equal(t, "", buildInfo.Error)
+	assert.Equal(t, "code", codeInfo.Error)
+	assert.Equal(t, "node; ssh unreachable", nodeInfo.Error)
+	assert.Equal(t, "[buildsight]: build failed", mailInfo.Subject)
+
+	i.cfg.Config.Spec.NodeConfig.Duration = "10ms"
+	node := &fakeNodeSight{delay: 200 * time.Millisecond, done: make(chan struct{})}
+	i.cfg.NodeSight = node
+
+	buildInfo, _, _, nodeInfo, err = i.Run(ctx, &proto.BuildTrigger{}, nil, &proto.NodeTrigger{})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "build", buildInfo.RootCause)
+	assert.Equal(t, true, strings.Contains(nodeInfo.Error, context.DeadlineExceeded.Error()))
+	<-node.done
+	assert.Equal(t, true, node.cancelled)
+
+	// The sight ignoring the context (e.g., hung ssh command) does not block Run.
+	node = &fakeNodeSight{delay: 500 * time.Millisecond, ignore: true, done: make(chan struct{})}
+	i.cfg.NodeSight = node
+
+	start := time.Now()
+	_, _, _, nodeInfo, err = i.Run(ctx, nil, nil, &proto.NodeTrigger{})
+	assert.Equal(t, nil, err)
+	assert.Less(t, time.Since(start), node.delay)
+	assert.Equal(t, true, strings.Contains(nodeInfo.Error, context.DeadlineExceeded.Error()))
+	<-node.done
+
+	i.cfg.Config.Spec.NodeConfig.Duration = "invalid"
+
+	_, _, _, nodeInfo, err = i.Run(ctx, nil, nil, &proto.NodeTrigger{})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, strings.Contains(nodeInfo.Error, "failed to parse duration"))
+}
+
+func TestMergeError(t *testing.T) {
+	err := errors.Wrap(errors.New("foo"), "failed to run")
+
+	assert.Equal(t, "failed to run: foo", mergeError("", err))
+	assert.Equal(t, "failed to run: foo", mergeError("foo", err))
+	assert.Equal(t, "bar; failed to run: foo", mergeError("bar", err))
+}
+
+func TestMergeMail(t *testing.T) {
+	ret := mergeMail()
+	assert.Equal(t, proto.MailInfo{}, ret)
+
+	ret = mergeMail(proto.MailInfo{}, proto.MailInfo{ContentType: "text/html", Subject: "foo"}, proto.MailInfo{})
+	assert.Equal(t, "text/html", ret.ContentType)
+	assert.Equal(t, "foo", ret.Subject)
+	assert.Equal(t, "", ret.Body)
+
+	ret = mergeMail(proto.MailInfo{ContentType: "text/plain", Subject: "foo", Body: "a < b"},
+		proto.MailInfo{ContentType: "text/html", Subject: "bar", Body: "<p>bar</p>"})
+	assert.Equal(t, "text/html", ret.ContentType)
+	assert.Equal(t, "foo; bar", ret.Subject)
+	assert.Equal(t, mail.HtmlBody("a < b")+"\n<hr>\n<p>bar</p>", ret.Body)
+
+	ret = mergeMail(proto.MailInfo{ContentType: "text/plain", Body: "foo"}, proto.MailInfo{ContentType: "text/plain", Body: "bar"})
+	assert.Equal(t, "text/plain", ret.ContentType)
+	assert.Equal(t, "foo\n\nbar", ret.Body)
 }
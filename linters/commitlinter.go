@@ -1,28 +1,59 @@
 package linters
 
 import (
-	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
 	"io"
+	"net/http"
 	"os"
 	"path/filepath"
+	"regexp"
 	"slices"
+	"strconv"
 	"strings"
+	"unicode"
+	"unicode/utf8"
 
+	"github.com/BurntSushi/toml"
 	"github.com/hashicorp/go-hclog"
+	"github.com/pkg/errors"
+	"golang.org/x/text/width"
+	"gopkg.in/yaml.v3"
 
 	"github.com/devops-pipeflow/insight-plugin/config"
 )
 
+const (
+	ruleConflict = "conflict"
+	ruleFile     = "file"
+	ruleIni      = "ini"
+	ruleJson     = "json"
+	ruleMessage  = "message"
+	ruleNewline  = "newline"
+	ruleToml     = "toml"
+	ruleXml      = "xml"
+	ruleYaml     = "yaml"
+)
+
+const (
+	linterCommit = "commitlinter"
+)
+
 const (
 	conflictHead = "<<<<<<< HEAD"
 	conflictTail = ">>>>>>> CHANGE"
 	newLine      = "\n"
 
 	descriptionMax = 80
+	fileChars      = `\:*?"<>|`
+	fileSize       = 1048576
+	fileSniff      = 512
+	messageComment = "#"
+	messageFile    = "COMMIT_MSG"
+	messageHeader  = "Parent:"
 	messageName    = "/COMMIT_MSG"
 	messageSep     = "Change-Id"
 	subjectMax     = 80
@@ -31,15 +62,45 @@ const (
 
 var (
 	conflictExcluded = []string{".apk", ".bin", ".so"}
+	fileTexts        = []string{"application/json", "application/xml", "image/svg+xml", "text/"}
+	iniIncluded      = []string{".ini"}
+	iniFiles         = []string{".editorconfig"}
 	jsonIncluded     = []string{".json"}
-	newlineIncluded  = []string{".te", "file_contexts"}
+	messageIncluded  = []string{messageFile}
+	newlineIncluded  = []string{".te"}
+	newlineFiles     = []string{"file_contexts"}
+	tomlIncluded     = []string{".toml"}
 	xmlIncluded      = []string{".xml"}
+	yamlIncluded     = []string{".yaml", ".yml"}
+)
+
+var (
+	fileDenylist = []string{
+		".7z", ".a", ".apk", ".bin", ".class", ".dll", ".exe", ".img", ".iso", ".jar", ".o", ".so", ".tar", ".tgz", ".zip",
+	}
+	fileReserved = []string{
+		"AUX", "CON", "NUL", "PRN",
+		"COM1", "COM2", "COM3", "COM4", "COM5", "COM6", "COM7", "COM8", "COM9",
+		"LPT1", "LPT2", "LPT3", "LPT4", "LPT5", "LPT6", "LPT7", "LPT8", "LPT9",
+	}
+)
+
+var (
+	yamlLine = regexp.MustCompile(`\bline (\d+)\b`)
+)
+
+var (
+	messageChangeId     = regexp.MustCompile(`^Change-Id: I[0-9a-f]{40}\s*$`)
+	messageConventional = regexp.MustCompile(`^(build|chore|ci|docs|feat|fix|perf|refactor|revert|style|test)(\([^()]+\))?!?: \S`)
+	messageModule       = regexp.MustCompile(`^\[[^\[\]]+\] ?\S`)
+	messageWip          = regexp.MustCompile(`(?i)(^|[\s\[(])wip([\s\]):]|$)`)
 )
 
 type CommitLinter interface {
 	Init(context.Context) error
 	Deinit(context.Context) error
-	Run(context.Context, string, []string) ([]string, error)
+	Run(context.Context, string, []string, []config.LintRule) ([]Finding, error)
+	RunTree(context.Context, string, []string, []config.LintRule, Tree) ([]Finding, error)
 }
 
 type CommitLinterConfig struct {
@@ -47,13 +108,24 @@ type CommitLinterConfig struct {
 	Logger hclog.Logger
 }
 
-type linterFunc func(context.Context, string, []string) ([]string, error)
+type linterFunc func(context.Context, string, []string, *config.LintRule) ([]Finding, error)
+
+// commitRule is a registered rule, the files to lint are matched by the extensions or the
+// file names of rule (all files if neither), which are the defaults overridden by LintRule.
+type commitRule struct {
+	lint linterFunc
+	rule config.LintRule
+}
 
 type commitlinter struct {
-	cfg    *CommitLinterConfig
-	linter map[string]linterFunc
+	cfg   *CommitLinterConfig
+	rules []commitRule
 }
 
+// treeKey is the context key of the tree per run, which the rules checking the change against
+// the branch (e.g., file) take by loadTree.
+type treeKey struct{}
+
 func CommitLinterNew(_ context.Context, cfg *CommitLinterConfig) CommitLinter {
 	return &commitlinter{
 		cfg: cfg,
@@ -67,13 +139,27 @@ func DefaultCommitLinterConfig() *CommitLinterConfig {
 func (cl *commitlinter) Init(_ context.Context) error {
 	cl.cfg.Logger.Debug("commitlinter: Init")
 
-	cl.linter = map[string]linterFunc{
-		"lintConflict": cl.lintConflict,
-		"lintJson":     cl.lintJson,
-		"lintMessage":  cl.lintMessage,
-		"lintNewline":  cl.lintNewline,
-		"lintXml":      cl.lintXml,
-	}
+	cl.rules = nil
+
+	cl.register(config.LintRule{Name: ruleBlueprint, Files: blueprintIncluded}, cl.lintBlueprint)
+	cl.register(config.LintRule{Name: ruleConflict}, cl.lintConflict)
+	cl.register(config.LintRule{Name: ruleContexts, Files: contextsIncluded}, cl.lintContexts)
+	cl.register(config.LintRule{Name: ruleFile, SizeMax: fileSize, Denylist: fileDenylist, IllegalChars: fileChars}, cl.lintFile)
+	cl.register(config.LintRule{Name: ruleIni, Extensions: iniIncluded, Files: iniFiles}, cl.lintIni)
+	cl.register(config.LintRule{Name: ruleJson, Extensions: jsonIncluded}, cl.lintJson)
+	cl.register(config.LintRule{Name: ruleManifest, Files: manifestIncluded}, cl.lintManifest)
+	cl.register(config.LintRule{
+		Name:           ruleMessage,
+		Files:          messageIncluded,
+		SubjectMin:     subjectMin,
+		SubjectMax:     subjectMax,
+		DescriptionMax: descriptionMax,
+	}, cl.lintMessage)
+	cl.register(config.LintRule{Name: ruleNewline, Extensions: newlineIncluded, Files: newlineFiles}, cl.lintNewline)
+	cl.register(config.LintRule{Name: ruleSepolicy, Extensions: sepolicyIncluded}, cl.lintSepolicy)
+	cl.register(config.LintRule{Name: ruleToml, Extensions: tomlIncluded}, cl.lintToml)
+	cl.register(config.LintRule{Name: ruleXml, Extensions: xmlIncluded}, cl.lintXml)
+	cl.register(config.LintRule{Name: ruleYaml, Extensions: yamlIncluded}, cl.lintYaml)
 
 	return nil
 }
@@ -84,28 +170,70 @@ func (cl *commitlinter) Deinit(_ context.Context) error {
 	return nil
 }
 
-func (cl *commitlinter) Run(ctx context.Context, path string, files []string) ([]string, error) {
+// Run runs the rules without the tree of branch, see RunTree.
+func (cl *commitlinter) Run(ctx context.Context, path string, files []string, rules []config.LintRule) ([]Finding, error) {
 	cl.cfg.Logger.Debug("commitlinter: Run")
 
-	var buf []string
-	var err error
+	return cl.RunTree(ctx, path, files, rules, nil)
+}
+
+// RunTree runs the rules in the order registered, the rules are configured by rules (e.g., of
+// the project) and the failed rules are reported in error without aborting the others. The
+// files of the change are checked against tree (e.g., path collisions) if not nil.
+func (cl *commitlinter) RunTree(ctx context.Context, path string, files []string, rules []config.LintRule,
+	tree Tree) ([]Finding, error) {
+	cl.cfg.Logger.Debug("commitlinter: RunTree")
+
+	if tree != nil {
+		ctx = context.WithValue(ctx, treeKey{}, tree)
+	}
+
+	var buf []Finding
+	var errs []string
 
-	for _, linter := range cl.linter {
-		var b []string
-		b, err = linter(ctx, path, files)
+	for _, item := range cl.rules {
+		rule := buildRule(&item.rule, rules)
+		if rule.Disabled {
+			continue
+		}
+		list := matchRule(&rule, files)
+		if len(list) == 0 {
+			continue
+		}
+		b, err := item.lint(ctx, path, list, &rule)
 		if err != nil {
-			break
+			errs = append(errs, rule.Name+": "+err.Error())
+			continue
 		}
 		buf = append(buf, b...)
 	}
 
-	return buf, err
+	if len(errs) != 0 {
+		return buf, errors.New(strings.Join(errs, "; "))
+	}
+
+	return buf, nil
 }
 
-func (cl *commitlinter) lintConflict(_ context.Context, path string, files []string) ([]string, error) {
+// register registers the rule with its defaults, the rule registered with the same name is
+// replaced in place.
+func (cl *commitlinter) register(rule config.LintRule, lint linterFunc) {
+	index := slices.IndexFunc(cl.rules, func(data commitRule) bool {
+		return data.rule.Name == rule.Name
+	})
+
+	if index >= 0 {
+		cl.rules[index] = commitRule{lint: lint, rule: rule}
+		return
+	}
+
+	cl.rules = append(cl.rules, commitRule{lint: lint, rule: rule})
+}
+
+func (cl *commitlinter) lintConflict(_ context.Context, path string, files []string, _ *config.LintRule) ([]Finding, error) {
 	cl.cfg.Logger.Debug("commitlinter: lintConflict")
 
-	var buf []string
+	var buf []Finding
 
 	for _, item := range files {
 		suffix := filepath.Ext(item)
@@ -118,35 +246,96 @@ func (cl *commitlinter) lintConflict(_ context.Context, path string, files []str
 		name := filepath.Join(path, item)
 		data, err := os.ReadFile(name)
 		if err != nil {
-			buf = append(buf, fmt.Sprintf("%s:%d:%s:%s", item, 0, "Error", err.Error()))
+			buf = append(buf, cl.buildFinding(ruleConflict, item, err.Error()))
 			continue
 		}
 
 		if strings.Contains(string(data), conflictHead) || strings.Contains(string(data), conflictTail) {
-			buf = append(buf, fmt.Sprintf("%s:%d:%s:%s", item, 0, "Error", "Conflict character found"))
+			buf = append(buf, cl.buildFinding(ruleConflict, item, "Conflict character found"))
 		}
 	}
 
 	return buf, nil
 }
 
-func (cl *commitlinter) lintJson(_ context.Context, path string, files []string) ([]string, error) {
-	cl.cfg.Logger.Debug("commitlinter: lintJson")
+// lintFile rejects the binary files sniffed by content, the files larger than the limit, the
+// files with the extensions in denylist, the path names with illegal characters (or reserved
+// on Windows) and the path names colliding case-insensitively with each other or with those
+// in the tree of branch (listed by repo, skipped without tree). The paths matched by allowlist
+// are allowed to be binary or in denylist.
+// nolint:gocyclo
+func (cl *commitlinter) lintFile(ctx context.Context, path string, files []string, rule *config.LintRule) ([]Finding, error) {
+	cl.cfg.Logger.Debug("commitlinter: lintFile")
 
-	var buf []string
+	allows, err := buildAllows(rule.Allowlist.Paths)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid allowlist")
+	}
+
+	var buf []Finding
+
+	names := map[string]string{}
+	dirs := map[string][]string{}
+	paths := changePaths(files)
+	tree := loadTree(ctx)
 
 	for _, item := range files {
-		suffix := filepath.Ext(item)
-		if slices.IndexFunc(jsonIncluded, func(data string) bool {
-			return data == suffix
-		}) < 0 {
+		if msg := checkPath(item, rule.IllegalChars); msg != "" {
+			buf = append(buf, cl.buildFinding(ruleFile, item, msg))
+		}
+
+		key := strings.ToLower(item)
+		if name, ok := names[key]; ok && name != item {
+			buf = append(buf, cl.buildFinding(ruleFile, item, fmt.Sprintf("Path collides case-insensitively with %s", name)))
+		} else if !ok {
+			names[key] = item
+			if name := collidePath(ctx, tree, item, paths, dirs); name != "" {
+				buf = append(buf, cl.buildFinding(ruleFile, item, fmt.Sprintf("Path collides case-insensitively with %s", name)))
+			}
+		}
+
+		allowed := matchAllows(allows, item)
+
+		if ext := strings.ToLower(filepath.Ext(item)); !allowed && slices.Contains(rule.Denylist, ext) {
+			buf = append(buf, cl.buildFinding(ruleFile, item, fmt.Sprintf("Extension %s not allowed", ext)))
+		}
+
+		name := filepath.Join(path, item)
+		info, err := os.Stat(name)
+		if err != nil {
+			buf = append(buf, cl.buildFinding(ruleFile, item, err.Error()))
+			continue
+		}
+
+		if info.Size() > rule.SizeMax {
+			buf = append(buf, cl.buildFinding(ruleFile, item,
+				fmt.Sprintf("File larger than %d bytes (found %d)", rule.SizeMax, info.Size())))
+		}
+
+		if allowed {
 			continue
 		}
 
+		if kind, err := sniffFile(name); err != nil {
+			buf = append(buf, cl.buildFinding(ruleFile, item, err.Error()))
+		} else if kind != "" {
+			buf = append(buf, cl.buildFinding(ruleFile, item, fmt.Sprintf("Binary file found (%s)", kind)))
+		}
+	}
+
+	return buf, nil
+}
+
+func (cl *commitlinter) lintJson(_ context.Context, path string, files []string, _ *config.LintRule) ([]Finding, error) {
+	cl.cfg.Logger.Debug("commitlinter: lintJson")
+
+	var buf []Finding
+
+	for _, item := range files {
 		name := filepath.Join(path, item)
 		data, err := os.ReadFile(name)
 		if err != nil {
-			buf = append(buf, fmt.Sprintf("%s:%d:%s:%s", item, 0, "Error", err.Error()))
+			buf = append(buf, cl.buildFinding(ruleJson, item, err.Error()))
 			continue
 		}
 
@@ -154,87 +343,148 @@ func (cl *commitlinter) lintJson(_ context.Context, path string, files []string)
 
 		err = json.Unmarshal(data, &d)
 		if err != nil {
-			buf = append(buf, fmt.Sprintf("%s:%d:%s:%s", item, 0, "Error", err.Error()))
+			b := cl.buildFinding(ruleJson, item, err.Error())
+			var e *json.SyntaxError
+			if errors.As(err, &e) {
+				b.Line, b.Column = offsetPosition(data, int(e.Offset)-1)
+			}
+			buf = append(buf, b)
 		}
 	}
 
 	return buf, nil
 }
 
-// nolint:gocyclo
-func (cl *commitlinter) lintMessage(_ context.Context, path string, files []string) ([]string, error) {
-	cl.cfg.Logger.Debug("commitlinter: lintLength")
+// lintIni checks the sections and the key-value pairs of INI, the indented lines following
+// a pair are taken as the continuation of value.
+func (cl *commitlinter) lintIni(_ context.Context, path string, files []string, _ *config.LintRule) ([]Finding, error) {
+	cl.cfg.Logger.Debug("commitlinter: lintIni")
+
+	var buf []Finding
 
-	loadMessage := func(name string) ([]string, error) {
-		var buf []string
-		file, err := os.Open(name)
+	for _, item := range files {
+		data, err := os.ReadFile(filepath.Join(path, item))
 		if err != nil {
-			return buf, err
+			buf = append(buf, cl.buildFinding(ruleIni, item, err.Error()))
+			continue
 		}
-		defer func(file *os.File) {
-			_ = file.Close()
-		}(file)
-		reader := bufio.NewReader(file)
-		for {
-			line, _, err := reader.ReadLine()
-			if err == io.EOF {
-				break
-			}
-			buf = append(buf, string(line))
+
+		if line, column, message := parseIni(string(data)); message != "" {
+			b := cl.buildFinding(ruleIni, item, message)
+			b.Line, b.Column = line, column
+			buf = append(buf, b)
 		}
-		return buf, nil
 	}
 
-	stripMessage := func(data []string) ([]string, error) {
-		index := -1
-		for i, d := range data {
-			if strings.Contains(d, messageSep) {
-				index = i + 1
-				break
-			}
-		}
-		if index > 0 {
-			return data[:index], nil
-		}
-		return []string{}, nil
+	return buf, nil
+}
+
+// lintMessage checks the Change-Id, the subject (length in display width, style, period and
+// WIP marker), the blank line after subject, the description length and the trailers. The
+// header of Gerrit (e.g., Parent, Author) is skipped if any.
+// nolint:funlen,gocyclo
+func (cl *commitlinter) lintMessage(_ context.Context, path string, files []string, rule *config.LintRule) ([]Finding, error) {
+	cl.cfg.Logger.Debug("commitlinter: lintMessage")
+
+	helper := func(line int, message string) Finding {
+		buf := cl.buildFinding(ruleMessage, messageName, message)
+		buf.Line = line
+		return buf
 	}
 
-	var buf []string
+	var buf []Finding
 
 	for _, item := range files {
-		name := filepath.Join(path, item)
-		lines, err := loadMessage(name)
+		data, err := os.ReadFile(filepath.Join(path, item))
 		if err != nil {
-			buf = append(buf, fmt.Sprintf("%s:%d:%s:%s", messageName, 0, "Error", "Failed to load message"))
+			buf = append(buf, helper(0, "Failed to load message"))
 			continue
 		}
-		lines, err = stripMessage(lines)
-		if err != nil {
-			buf = append(buf, fmt.Sprintf("%s:%d:%s:%s", messageName, 0, "Error", "Failed to strip message"))
+
+		lines := strings.Split(strings.TrimRight(string(data), newLine), newLine)
+
+		start := 0
+		if len(lines) != 0 && strings.HasPrefix(lines[0], messageHeader) {
+			for start < len(lines) && strings.TrimSpace(lines[start]) != "" {
+				start++
+			}
+		}
+
+		for start < len(lines) && strings.TrimSpace(lines[start]) == "" {
+			start++
+		}
+
+		if start >= len(lines) {
+			buf = append(buf, helper(0, "Empty message"))
 			continue
 		}
-		for index, line := range lines {
-			line = strings.TrimSpace(line)
-			if line == "" {
+
+		// Change-Id
+		end := len(lines)
+		for i := len(lines) - 1; i > start; i-- {
+			if !strings.HasPrefix(lines[i], messageSep+":") {
+				continue
+			}
+			if !messageChangeId.MatchString(lines[i]) {
+				buf = append(buf, helper(i+1, fmt.Sprintf("Invalid Change-Id (found %q)", strings.TrimSpace(lines[i]))))
+			}
+			end = i
+			break
+		}
+
+		if end == len(lines) {
+			buf = append(buf, helper(0, "Change-Id not found"))
+		}
+
+		// Subject
+		subject := strings.TrimSpace(lines[start])
+		if w := int64(displayWidth(subject)); w < rule.SubjectMin {
+			buf = append(buf, helper(start+1, fmt.Sprintf("Subject shorter than %d characters (found %d)", rule.SubjectMin, w)))
+		} else if w > rule.SubjectMax {
+			buf = append(buf, helper(start+1, fmt.Sprintf("Subject longer than %d characters (found %d)", rule.SubjectMax, w)))
+		}
+
+		if strings.HasSuffix(subject, ".") || strings.HasSuffix(subject, "。") {
+			buf = append(buf, helper(start+1, "Subject ends with a period"))
+		}
+
+		if messageWip.MatchString(subject) {
+			buf = append(buf, helper(start+1, "Subject marked as work in progress"))
+		}
+
+		switch rule.SubjectStyle {
+		case config.StyleConventional:
+			if !messageConventional.MatchString(subject) {
+				buf = append(buf, helper(start+1, "Subject not in Conventional Commits (e.g., \"fix(module): ...\")"))
+			}
+		case config.StyleModule:
+			if !messageModule.MatchString(subject) {
+				buf = append(buf, helper(start+1, "Subject without module prefix (e.g., \"[module] ...\")"))
+			}
+		}
+
+		if start+1 < end && strings.TrimSpace(lines[start+1]) != "" {
+			buf = append(buf, helper(start+2, "No blank line after subject"))
+		}
+
+		// Description
+		for i := start + 1; i < end; i++ {
+			line := strings.TrimSpace(lines[i])
+			if line == "" || strings.HasPrefix(line, messageComment) {
 				continue
 			}
-			if index == 0 {
-				if len(line) < subjectMin {
-					buf = append(buf, fmt.Sprintf("%s:%d:%s:%s", messageName, 0, "Error",
-						fmt.Sprintf("Subject shorter than %d characters (found %d)", subjectMin, len(line))))
-				} else if len(line) > subjectMax {
-					buf = append(buf, fmt.Sprintf("%s:%d:%s:%s", messageName, 0, "Error",
-						fmt.Sprintf("Subject longer than %d characters (found %d)", subjectMax, len(line))))
-				} else {
-					// PASS
-				}
-			} else {
-				if len(line) > descriptionMax {
-					buf = append(buf, fmt.Sprintf("%s:%d:%s:%s", messageName, 0, "Error",
-						fmt.Sprintf("Description longer than %d characters (found %d)", descriptionMax, len(line))))
-				} else {
-					// PASS
-				}
+			if w := int64(displayWidth(line)); w > rule.DescriptionMax {
+				buf = append(buf, helper(i+1, fmt.Sprintf("Description longer than %d characters (found %d)", rule.DescriptionMax, w)))
+			}
+		}
+
+		// Trailers
+		for _, name := range rule.Trailers {
+			if !slices.ContainsFunc(lines[start+1:], func(data string) bool {
+				val, ok := strings.CutPrefix(data, name+":")
+				return ok && strings.TrimSpace(val) != ""
+			}) {
+				buf = append(buf, helper(0, fmt.Sprintf("Trailer %s not found", name)))
 			}
 		}
 	}
@@ -242,65 +492,369 @@ func (cl *commitlinter) lintMessage(_ context.Context, path string, files []stri
 	return buf, nil
 }
 
-func (cl *commitlinter) lintNewline(_ context.Context, path string, files []string) ([]string, error) {
+func (cl *commitlinter) lintNewline(_ context.Context, path string, files []string, _ *config.LintRule) ([]Finding, error) {
 	cl.cfg.Logger.Debug("commitlinter: lintNewline")
 
-	var buf []string
+	var buf []Finding
 
 	for _, item := range files {
-		suffix := filepath.Ext(item)
-		if slices.IndexFunc(newlineIncluded, func(data string) bool {
-			if suffix != "" {
-				return data == suffix
-			} else {
-				return data == item
-			}
-		}) < 0 {
-			continue
-		}
-
 		name := filepath.Join(path, item)
 		data, err := os.ReadFile(name)
 		if err != nil {
-			buf = append(buf, fmt.Sprintf("%s:%d:%s:%s", item, 0, "Error", err.Error()))
+			buf = append(buf, cl.buildFinding(ruleNewline, item, err.Error()))
 			continue
 		}
 
 		if !strings.HasSuffix(string(data), newLine) {
-			buf = append(buf, fmt.Sprintf("%s:%d:%s:%s", item, 0, "Error", "No newline at end of file"))
+			buf = append(buf, cl.buildFinding(ruleNewline, item, "No newline at end of file"))
 		}
 	}
 
 	return buf, nil
 }
 
-func (cl *commitlinter) lintXml(_ context.Context, path string, files []string) ([]string, error) {
+func (cl *commitlinter) lintXml(_ context.Context, path string, files []string, _ *config.LintRule) ([]Finding, error) {
 	cl.cfg.Logger.Debug("commitlinter: lintXml")
 
-	var buf []string
+	var buf []Finding
 
 	for _, item := range files {
-		suffix := filepath.Ext(item)
-		if slices.IndexFunc(xmlIncluded, func(data string) bool {
-			return data == suffix
-		}) < 0 {
+		name := filepath.Join(path, item)
+		data, err := os.ReadFile(name)
+		if err != nil {
+			buf = append(buf, cl.buildFinding(ruleXml, item, err.Error()))
 			continue
 		}
 
-		name := filepath.Join(path, item)
-		data, err := os.ReadFile(name)
+		decoder := xml.NewDecoder(bytes.NewReader(data))
+
+		for {
+			_, err = decoder.Token()
+			if err != nil {
+				break
+			}
+		}
+
+		if !errors.Is(err, io.EOF) {
+			b := cl.buildFinding(ruleXml, item, err.Error())
+			b.Line, b.Column = decoder.InputPos()
+			buf = append(buf, b)
+		}
+	}
+
+	return buf, nil
+}
+
+func (cl *commitlinter) lintToml(_ context.Context, path string, files []string, _ *config.LintRule) ([]Finding, error) {
+	cl.cfg.Logger.Debug("commitlinter: lintToml")
+
+	var buf []Finding
+
+	for _, item := range files {
+		data, err := os.ReadFile(filepath.Join(path, item))
 		if err != nil {
-			buf = append(buf, fmt.Sprintf("%s:%d:%s:%s", item, 0, "Error", err.Error()))
+			buf = append(buf, cl.buildFinding(ruleToml, item, err.Error()))
 			continue
 		}
 
-		var d interface{}
+		var d map[string]interface{}
 
-		err = xml.Unmarshal(data, &d)
+		_, err = toml.Decode(string(data), &d)
 		if err != nil {
-			buf = append(buf, fmt.Sprintf("%s:%d:%s:%s", item, 0, "Error", err.Error()))
+			b := cl.buildFinding(ruleToml, item, err.Error())
+			var e toml.ParseError
+			if errors.As(err, &e) {
+				b.Line, b.Column = offsetPosition(data, e.Position.Start)
+			}
+			buf = append(buf, b)
+		}
+	}
+
+	return buf, nil
+}
+
+// lintYaml checks all documents of YAML, see parseYaml for the position reported.
+func (cl *commitlinter) lintYaml(_ context.Context, path string, files []string, _ *config.LintRule) ([]Finding, error) {
+	cl.cfg.Logger.Debug("commitlinter: lintYaml")
+
+	var buf []Finding
+
+	for _, item := range files {
+		data, err := os.ReadFile(filepath.Join(path, item))
+		if err != nil {
+			buf = append(buf, cl.buildFinding(ruleYaml, item, err.Error()))
+			continue
+		}
+
+		if line, column, message := parseYaml(data); message != "" {
+			b := cl.buildFinding(ruleYaml, item, message)
+			b.Line, b.Column = line, column
+			buf = append(buf, b)
 		}
 	}
 
 	return buf, nil
 }
+
+func (cl *commitlinter) buildFinding(rule, file, message string) Finding {
+	return Finding{
+		Linter:   linterCommit,
+		Rule:     rule,
+		Severity: SeverityError,
+		File:     file,
+		Message:  message,
+	}
+}
+
+// parseYaml returns the line, the column and the message of the first error in the documents
+// of YAML. The errors of decoding (e.g., duplicate keys) are located by the nodes, the syntax
+// errors are located by line only (column 0) since no column is reported by yaml.v3.
+func parseYaml(data []byte) (line, column int, message string) {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+
+	helper := func(err error) int {
+		if match := yamlLine.FindStringSubmatch(err.Error()); match != nil {
+			line, _ := strconv.Atoi(match[1])
+			return line
+		}
+		return 0
+	}
+
+	for {
+		var node yaml.Node
+		if err := decoder.Decode(&node); errors.Is(err, io.EOF) {
+			return 0, 0, ""
+		} else if err != nil {
+			return helper(err), 0, err.Error()
+		}
+		var d interface{}
+		err := node.Decode(&d)
+		if err == nil {
+			continue
+		}
+		line = helper(err)
+		if key := duplicateKey(&node); key != nil {
+			line, column = key.Line, key.Column
+		} else if item := lastNode(&node, line); item != nil {
+			column = item.Column
+		}
+		return line, column, err.Error()
+	}
+}
+
+// duplicateKey returns the first key node duplicating a previous key in the same mapping.
+func duplicateKey(node *yaml.Node) *yaml.Node {
+	if node.Kind == yaml.MappingNode {
+		keys := map[string]bool{}
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			key := node.Content[i]
+			if key.Kind != yaml.ScalarNode || key.Tag == "!!merge" {
+				continue
+			}
+			if keys[key.Tag+":"+key.Value] {
+				return key
+			}
+			keys[key.Tag+":"+key.Value] = true
+		}
+	}
+
+	for _, item := range node.Content {
+		if key := duplicateKey(item); key != nil {
+			return key
+		}
+	}
+
+	return nil
+}
+
+// lastNode returns the last node starting on the line in document order, nil if none.
+func lastNode(node *yaml.Node, line int) *yaml.Node {
+	var buf *yaml.Node
+
+	if node.Line == line && node.Kind != yaml.DocumentNode {
+		buf = node
+	}
+
+	for _, item := range node.Content {
+		if n := lastNode(item, line); n != nil {
+			buf = n
+		}
+	}
+
+	return buf
+}
+
+// offsetPosition returns the line and the column (in characters) of the byte offset in data.
+func offsetPosition(data []byte, offset int) (line, column int) {
+	offset = max(min(offset, len(data)), 0)
+
+	start := bytes.LastIndexByte(data[:offset], '\n') + 1
+
+	return bytes.Count(data[:offset], []byte(newLine)) + 1, utf8.RuneCount(data[start:offset]) + 1
+}
+
+// parseIni returns the line, the column and the message of the first error in INI.
+func parseIni(data string) (line, column int, message string) {
+	pair := false
+
+	for index, item := range strings.Split(data, newLine) {
+		text := strings.TrimRight(item, " \t\r")
+		trimmed := strings.TrimLeft(text, " \t")
+		column := len(text) - len(trimmed) + 1
+		switch {
+		case trimmed == "" || strings.HasPrefix(trimmed, ";") || strings.HasPrefix(trimmed, "#"):
+			continue
+		case pair && column > 1:
+			continue
+		case strings.HasPrefix(trimmed, "["):
+			end := strings.Index(trimmed, "]")
+			if end < 0 {
+				return index + 1, column + utf8.RuneCountInString(trimmed), "Unclosed section header"
+			}
+			if strings.TrimSpace(trimmed[1:end]) == "" {
+				return index + 1, column, "Empty section name"
+			}
+			if rest := strings.TrimSpace(trimmed[end+1:]); rest != "" && !strings.HasPrefix(rest, ";") && !strings.HasPrefix(rest, "#") {
+				return index + 1, column + utf8.RuneCountInString(trimmed[:end+1]), "Unexpected characters after section header"
+			}
+			pair = false
+		default:
+			sep := strings.IndexAny(trimmed, "=:")
+			if sep < 0 {
+				return index + 1, column, "Missing \"=\" in key-value pair"
+			}
+			if strings.TrimSpace(trimmed[:sep]) == "" {
+				return index + 1, column, "Empty key"
+			}
+			pair = true
+		}
+	}
+
+	return 0, 0, ""
+}
+
+// checkPath returns the message if name has the illegal characters, the control characters,
+// the components ending with space or period, or the names reserved on Windows (e.g., NUL).
+func checkPath(name, chars string) string {
+	if !utf8.ValidString(name) {
+		return "Path name not in UTF-8"
+	}
+
+	if i := strings.IndexFunc(name, func(r rune) bool {
+		return unicode.IsControl(r) || strings.ContainsRune(chars, r)
+	}); i >= 0 {
+		return fmt.Sprintf("Path name with illegal character %q", []rune(name[i:])[0])
+	}
+
+	for _, item := range strings.Split(name, "/") {
+		if strings.HasSuffix(item, " ") || (strings.HasSuffix(item, ".") && item != "." && item != "..") {
+			return "Path name ends with space or period"
+		}
+		base, _, _ := strings.Cut(item, ".")
+		if slices.Contains(fileReserved, strings.ToUpper(base)) {
+			return fmt.Sprintf("Path name %s reserved on Windows", item)
+		}
+	}
+
+	return ""
+}
+
+// changePaths returns the paths in the change, including the directories of the files.
+func changePaths(files []string) map[string]bool {
+	buf := map[string]bool{}
+
+	for _, item := range files {
+		for name := item; name != "." && name != "/" && name != ""; name = filepath.Dir(name) {
+			buf[name] = true
+		}
+	}
+
+	return buf
+}
+
+// loadTree returns the tree of the run, nil if not set (e.g., by Run).
+func loadTree(ctx context.Context) Tree {
+	tree, _ := ctx.Value(treeKey{}).(Tree)
+	return tree
+}
+
+// collidePath returns the path in the tree which collides case-insensitively with name, the
+// paths in the change are skipped and the directories listed are cached in dirs. The
+// directories failed to list (e.g., added by the change) are taken as empty.
+func collidePath(ctx context.Context, tree Tree, name string, paths map[string]bool, dirs map[string][]string) string {
+	if tree == nil {
+		return ""
+	}
+
+	dir := ""
+
+	for _, item := range strings.Split(name, "/") {
+		entries, ok := dirs[dir]
+		if !ok {
+			entries, _ = tree.List(ctx, tree.Project(), dir)
+			dirs[dir] = entries
+		}
+
+		for _, entry := range entries {
+			other := filepath.Join(dir, entry)
+			if entry != item && strings.EqualFold(entry, item) && !paths[other] {
+				return other
+			}
+		}
+
+		dir = filepath.Join(dir, item)
+	}
+
+	return ""
+}
+
+// sniffFile returns the content type of name if binary, which is sniffed by the leading bytes.
+func sniffFile(name string) (string, error) {
+	f, err := os.Open(name)
+	if err != nil {
+		return "", err
+	}
+
+	defer func() {
+		_ = f.Close()
+	}()
+
+	data := make([]byte, fileSniff)
+
+	n, err := io.ReadFull(f, data)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", err
+	}
+
+	if n == 0 {
+		return "", nil
+	}
+
+	kind := http.DetectContentType(data[:n])
+	if slices.ContainsFunc(fileTexts, func(data string) bool {
+		return strings.HasPrefix(kind, data)
+	}) {
+		return "", nil
+	}
+
+	kind, _, _ = strings.Cut(kind, ";")
+
+	return kind, nil
+}
+
+// displayWidth returns the width of data in columns, the wide and fullwidth characters (e.g.,
+// CJK) take 2 columns.
+func displayWidth(data string) int {
+	var buf int
+
+	for _, item := range data {
+		switch width.LookupRune(item).Kind() {
+		case width.EastAsianWide, width.EastAsianFullwidth:
+			buf += 2
+		default:
+			buf++
+		}
+	}
+
+	return buf
+}
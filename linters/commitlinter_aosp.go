@@ -0,0 +1,720 @@
+package linters
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/pkg/errors"
+
+	"github.com/devops-pipeflow/insight-plugin/config"
+)
+
+const (
+	ruleBlueprint = "blueprint"
+	ruleContexts  = "contexts"
+	ruleManifest  = "manifest"
+	ruleSepolicy  = "sepolicy"
+)
+
+const (
+	contextsNone     = "<<none>>"
+	manifestRoot     = "manifest"
+	manifestApp      = "application"
+	manifestNs       = "http://schemas.android.com/apk/res/android"
+	sepolicyComment  = "#"
+	sepolicyPlatform = "platform/system/sepolicy"
+	sepolicySelf     = "self"
+	sepolicyWildcard = "*"
+)
+
+var (
+	blueprintIncluded = []string{"Android.bp"}
+	contextsIncluded  = []string{"file_contexts"}
+	manifestIncluded  = []string{"AndroidManifest.xml"}
+	sepolicyIncluded  = []string{".te"}
+
+	// The platform policy is declared in the policy files and the attributes files of the dirs.
+	sepolicyDirs  = []string{"public", "private", "vendor"}
+	sepolicyFiles = []string{"attributes"}
+)
+
+var (
+	contextsContext = regexp.MustCompile(`^[a-z0-9_]+:object_r:[A-Za-z0-9_]+:s0(:c[0-9]+([.,]c[0-9]+)*)?$`)
+	contextsTypes   = []string{"--", "-b", "-c", "-d", "-l", "-p", "-s"}
+	sepolicyAlias   = regexp.MustCompile(`\balias\s+(\{[^}]*\}|[A-Za-z0-9_]+)`)
+	sepolicyAllow   = regexp.MustCompile(`(?:^|[\s;()])(allow|auditallow|dontaudit)\s+(~?\{[^}]*\}|[^\s{};:]+)\s+` +
+		`(~?\{[^}]*\}|[^\s{};:]+)\s*:\s*(~?\{[^}]*\}|[^\s{};:]+)\s*(~?\{[^}]*\}|[^\s{};]+)\s*;`)
+	sepolicyDefine = regexp.MustCompile(`(?:^|[\s;()])(?:type|attribute)\s+([A-Za-z0-9_]+)`)
+)
+
+// lintSepolicy checks the balanced parentheses and braces, the types in the allow rules not
+// declared in the policy files of the change or in the platform policy (system/sepolicy of the
+// tree, matched by allowlist for others), and the wildcards or complements in the allow rules
+// which are prone to violate neverallow rules. The types are not checked without tree, and are
+// warned since the policy of other projects (e.g., device) is not read.
+// nolint:gocyclo
+func (cl *commitlinter) lintSepolicy(ctx context.Context, path string, files []string, rule *config.LintRule) ([]Finding, error) {
+	cl.cfg.Logger.Debug("commitlinter: lintSepolicy")
+
+	allows, err := buildAllows(rule.Allowlist.Regexes)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid allowlist")
+	}
+
+	tree := loadTree(ctx)
+
+	var defined map[string]bool
+
+	// The types are not checked if the platform policy failed to read (e.g., not in the repo).
+	if tree != nil {
+		if platform, err := loadPlatform(ctx, tree); err == nil {
+			defined, err = loadTypes(path)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to load types")
+			}
+			for key := range platform {
+				defined[key] = true
+			}
+		} else {
+			cl.cfg.Logger.Warn("commitlinter: failed to load platform: " + err.Error())
+		}
+	}
+
+	helper := func(file string, line int, severity, message string) Finding {
+		buf := cl.buildFinding(ruleSepolicy, file, message)
+		buf.Line = line
+		buf.Severity = severity
+		return buf
+	}
+
+	var buf []Finding
+
+	for _, item := range files {
+		data, err := os.ReadFile(filepath.Join(path, item))
+		if err != nil {
+			buf = append(buf, cl.buildFinding(ruleSepolicy, item, err.Error()))
+			continue
+		}
+
+		text := stripComments(string(data))
+
+		if line, message := checkBalanced(text); message != "" {
+			buf = append(buf, helper(item, line, SeverityError, message))
+		}
+
+		for _, match := range sepolicyAllow.FindAllStringSubmatchIndex(text, -1) {
+			line := strings.Count(text[:match[2]], newLine) + 1
+			for _, index := range []int{2, 3} {
+				if defined == nil {
+					break
+				}
+				for _, name := range splitSet(text[match[2*index]:match[2*index+1]]) {
+					name = strings.TrimLeft(name, "-~")
+					if name == sepolicyWildcard || name == sepolicySelf || defined[name] || matchAllows(allows, name) {
+						continue
+					}
+					if strings.ContainsAny(name, "$`'") {
+						continue
+					}
+					buf = append(buf, helper(item, line, SeverityWarn, fmt.Sprintf("Type %s not defined", name)))
+				}
+			}
+			for index := 2; index <= 5; index++ {
+				if set := text[match[2*index]:match[2*index+1]]; strings.Contains(set, sepolicyWildcard) || strings.HasPrefix(set, "~") {
+					buf = append(buf, helper(item, line, SeverityWarn, "Wildcard or complement in allow rule (neverallow-prone)"))
+					break
+				}
+			}
+		}
+	}
+
+	return buf, nil
+}
+
+// lintContexts checks the format and the path regexps of file_contexts, and the duplicate paths.
+func (cl *commitlinter) lintContexts(_ context.Context, path string, files []string, _ *config.LintRule) ([]Finding, error) {
+	cl.cfg.Logger.Debug("commitlinter: lintContexts")
+
+	helper := func(file string, line int, message string) Finding {
+		buf := cl.buildFinding(ruleContexts, file, message)
+		buf.Line = line
+		return buf
+	}
+
+	var buf []Finding
+
+	for _, item := range files {
+		data, err := os.ReadFile(filepath.Join(path, item))
+		if err != nil {
+			buf = append(buf, cl.buildFinding(ruleContexts, item, err.Error()))
+			continue
+		}
+
+		seen := map[string]int{}
+
+		for index, line := range strings.Split(string(data), newLine) {
+			line = strings.TrimSpace(line)
+			if line == "" || strings.HasPrefix(line, sepolicyComment) {
+				continue
+			}
+			fields := strings.Fields(line)
+			if len(fields) < 2 || len(fields) > 3 {
+				buf = append(buf, helper(item, index+1, "Invalid entry (expected \"path [type] context\")"))
+				continue
+			}
+			if len(fields) == 3 && !slices.Contains(contextsTypes, fields[1]) {
+				buf = append(buf, helper(item, index+1, fmt.Sprintf("Invalid file type %s", fields[1])))
+			}
+			if c := fields[len(fields)-1]; c != contextsNone && !contextsContext.MatchString(c) {
+				buf = append(buf, helper(item, index+1, fmt.Sprintf("Invalid context %s", c)))
+			}
+			if _, err := regexp.Compile("^(?:" + fields[0] + ")$"); err != nil {
+				buf = append(buf, helper(item, index+1, fmt.Sprintf("Invalid regexp %s", fields[0])))
+			}
+			key := strings.Join(fields[:len(fields)-1], " ")
+			if first, ok := seen[key]; ok {
+				buf = append(buf, helper(item, index+1, fmt.Sprintf("Duplicate path %s (first at line %d)", fields[0], first)))
+			} else {
+				seen[key] = index + 1
+			}
+		}
+	}
+
+	return buf, nil
+}
+
+// lintBlueprint checks the syntax of Android.bp (Blueprint), the first error of each file is reported.
+func (cl *commitlinter) lintBlueprint(_ context.Context, path string, files []string, _ *config.LintRule) ([]Finding, error) {
+	cl.cfg.Logger.Debug("commitlinter: lintBlueprint")
+
+	var buf []Finding
+
+	for _, item := range files {
+		data, err := os.ReadFile(filepath.Join(path, item))
+		if err != nil {
+			buf = append(buf, cl.buildFinding(ruleBlueprint, item, err.Error()))
+			continue
+		}
+
+		if err := parseBlueprint(string(data)); err != nil {
+			b := cl.buildFinding(ruleBlueprint, item, err.message)
+			b.Line, b.Column = err.line, err.column
+			buf = append(buf, b)
+		}
+	}
+
+	return buf, nil
+}
+
+// lintManifest checks AndroidManifest.xml is well-formed with the root manifest, the android
+// namespace declared and one application at most.
+func (cl *commitlinter) lintManifest(_ context.Context, path string, files []string, _ *config.LintRule) ([]Finding, error) {
+	cl.cfg.Logger.Debug("commitlinter: lintManifest")
+
+	var buf []Finding
+
+	for _, item := range files {
+		data, err := os.ReadFile(filepath.Join(path, item))
+		if err != nil {
+			buf = append(buf, cl.buildFinding(ruleManifest, item, err.Error()))
+			continue
+		}
+
+		if line, message := checkManifest(string(data)); message != "" {
+			b := cl.buildFinding(ruleManifest, item, message)
+			b.Line = line
+			buf = append(buf, b)
+		}
+	}
+
+	return buf, nil
+}
+
+// loadTypes returns the types, attributes and aliases declared in the policy files under path.
+func loadTypes(path string) (map[string]bool, error) {
+	buf := map[string]bool{}
+
+	err := filepath.WalkDir(path, func(name string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() || !slices.Contains(sepolicyIncluded, filepath.Ext(name)) {
+			return nil
+		}
+		data, err := os.ReadFile(name)
+		if err != nil {
+			return err
+		}
+		parseTypes(string(data), buf)
+		return nil
+	})
+
+	return buf, err
+}
+
+// loadPlatform returns the types, attributes and aliases declared in the platform policy read
+// from tree, the dirs missing in the branch are skipped.
+func loadPlatform(ctx context.Context, tree Tree) (map[string]bool, error) {
+	buf := map[string]bool{}
+
+	for _, dir := range sepolicyDirs {
+		names, err := tree.List(ctx, sepolicyPlatform, dir)
+		if err != nil {
+			continue
+		}
+		for _, item := range names {
+			if !slices.Contains(sepolicyIncluded, filepath.Ext(item)) && !slices.Contains(sepolicyFiles, item) {
+				continue
+			}
+			data, err := tree.Read(ctx, sepolicyPlatform, dir+"/"+item)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to read "+dir+"/"+item)
+			}
+			parseTypes(string(data), buf)
+		}
+	}
+
+	if len(buf) == 0 {
+		return nil, errors.New("no types found")
+	}
+
+	return buf, nil
+}
+
+// parseTypes adds the types, attributes and aliases declared in the policy data to buf.
+func parseTypes(data string, buf map[string]bool) {
+	text := stripComments(data)
+
+	for _, item := range sepolicyDefine.FindAllStringSubmatch(text, -1) {
+		buf[item[1]] = true
+	}
+
+	for _, item := range sepolicyAlias.FindAllStringSubmatch(text, -1) {
+		for _, name := range splitSet(item[1]) {
+			buf[name] = true
+		}
+	}
+}
+
+// stripComments blanks the comments of policy out with the lines kept.
+func stripComments(data string) string {
+	lines := strings.Split(data, newLine)
+
+	for i, item := range lines {
+		if index := strings.Index(item, sepolicyComment); index >= 0 {
+			lines[i] = item[:index]
+		}
+	}
+
+	return strings.Join(lines, newLine)
+}
+
+// checkBalanced returns the line and the message of the first unbalanced parenthesis or brace.
+func checkBalanced(data string) (int, string) {
+	pairs := map[rune]rune{')': '(', '}': '{'}
+
+	type open struct {
+		char rune
+		line int
+	}
+
+	var stack []open
+
+	line := 1
+
+	for _, item := range data {
+		switch item {
+		case '\n':
+			line++
+		case '(', '{':
+			stack = append(stack, open{char: item, line: line})
+		case ')', '}':
+			if len(stack) == 0 || stack[len(stack)-1].char != pairs[item] {
+				return line, fmt.Sprintf("Unbalanced %q", item)
+			}
+			stack = stack[:len(stack)-1]
+		}
+	}
+
+	if len(stack) != 0 {
+		return stack[len(stack)-1].line, fmt.Sprintf("Unclosed %q", stack[len(stack)-1].char)
+	}
+
+	return 0, ""
+}
+
+// splitSet returns the names in a set (e.g., "{ foo bar }") or the name itself.
+func splitSet(data string) []string {
+	return strings.Fields(strings.Trim(data, "{}"))
+}
+
+func checkManifest(data string) (int, string) {
+	decoder := xml.NewDecoder(strings.NewReader(data))
+
+	depth := 0
+	apps := 0
+	root := false
+
+	for {
+		token, err := decoder.Token()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			line, _ := decoder.InputPos()
+			var e *xml.SyntaxError
+			if errors.As(err, &e) {
+				line = e.Line
+			}
+			return line, err.Error()
+		}
+		elem, ok := token.(xml.StartElement)
+		if !ok {
+			if _, ok := token.(xml.EndElement); ok {
+				depth--
+			}
+			continue
+		}
+		line, _ := decoder.InputPos()
+		depth++
+		root = true
+		switch {
+		case depth == 1 && elem.Name.Local != manifestRoot:
+			return line, fmt.Sprintf("Root element %s not %s", elem.Name.Local, manifestRoot)
+		case depth == 1 && !slices.ContainsFunc(elem.Attr, func(attr xml.Attr) bool {
+			return attr.Name.Space == "xmlns" && attr.Value == manifestNs
+		}):
+			return line, "Namespace android not declared"
+		case depth == 2 && elem.Name.Local == manifestApp:
+			if apps++; apps > 1 {
+				return line, "Multiple application elements found"
+			}
+		}
+	}
+
+	if !root {
+		return 0, "Root element manifest not found"
+	}
+
+	return 0, ""
+}
+
+// blueprintError is a syntax error of Blueprint at line and column.
+type blueprintError struct {
+	line    int
+	column  int
+	message string
+}
+
+// blueprintParser is a recursive descent parser of Blueprint, see
+// https://android.googlesource.com/platform/build/blueprint/+/refs/heads/main/parser/parser.go
+type blueprintParser struct {
+	data   []rune
+	pos    int
+	line   int
+	column int
+}
+
+func parseBlueprint(data string) *blueprintError {
+	p := &blueprintParser{data: []rune(data), line: 1, column: 1}
+
+	return p.parseFile()
+}
+
+func (p *blueprintParser) errorf(format string, args ...interface{}) *blueprintError {
+	return &blueprintError{line: p.line, column: p.column, message: fmt.Sprintf(format, args...)}
+}
+
+func (p *blueprintParser) next() rune {
+	r := p.data[p.pos]
+	p.pos++
+
+	if r == '\n' {
+		p.line++
+		p.column = 1
+	} else {
+		p.column++
+	}
+
+	return r
+}
+
+func (p *blueprintParser) peek(offset int) rune {
+	if p.pos+offset >= len(p.data) {
+		return 0
+	}
+
+	return p.data[p.pos+offset]
+}
+
+// skip skips the spaces and the comments.
+func (p *blueprintParser) skip() *blueprintError {
+	for p.pos < len(p.data) {
+		switch {
+		case unicode.IsSpace(p.peek(0)):
+			p.next()
+		case p.peek(0) == '/' && p.peek(1) == '/':
+			for p.pos < len(p.data) && p.peek(0) != '\n' {
+				p.next()
+			}
+		case p.peek(0) == '/' && p.peek(1) == '*':
+			err := p.errorf("Unclosed comment")
+			p.next()
+			p.next()
+			for p.peek(0) != '*' || p.peek(1) != '/' {
+				if p.pos >= len(p.data) {
+					return err
+				}
+				p.next()
+			}
+			p.next()
+			p.next()
+		default:
+			return nil
+		}
+	}
+
+	return nil
+}
+
+func (p *blueprintParser) ident() string {
+	start := p.pos
+
+	if unicode.IsDigit(p.peek(0)) {
+		return ""
+	}
+
+	for p.pos < len(p.data) && (p.peek(0) == '_' || unicode.IsLetter(p.peek(0)) || unicode.IsDigit(p.peek(0))) {
+		p.next()
+	}
+
+	return string(p.data[start:p.pos])
+}
+
+func (p *blueprintParser) expect(char rune) *blueprintError {
+	if err := p.skip(); err != nil {
+		return err
+	}
+
+	if p.peek(0) != char {
+		return p.unexpected(fmt.Sprintf("%q", char))
+	}
+
+	p.next()
+
+	return nil
+}
+
+func (p *blueprintParser) unexpected(expected string) *blueprintError {
+	if p.pos >= len(p.data) {
+		return p.errorf("Unexpected end of file (expected %s)", expected)
+	}
+
+	return p.errorf("Unexpected %q (expected %s)", p.peek(0), expected)
+}
+
+func (p *blueprintParser) parseFile() *blueprintError {
+	for {
+		if err := p.skip(); err != nil {
+			return err
+		}
+		if p.pos >= len(p.data) {
+			return nil
+		}
+		if name := p.ident(); name == "" {
+			return p.unexpected("assignment or module")
+		}
+		if err := p.skip(); err != nil {
+			return err
+		}
+		switch {
+		case p.peek(0) == '=':
+			p.next()
+		case p.peek(0) == '+' && p.peek(1) == '=':
+			p.next()
+			p.next()
+		case p.peek(0) == '{':
+			p.next()
+			if err := p.parseProperties('}'); err != nil {
+				return err
+			}
+			continue
+		case p.peek(0) == '(':
+			p.next()
+			if err := p.parseProperties(')'); err != nil {
+				return err
+			}
+			continue
+		default:
+			return p.unexpected(`"=", "+=" or "{"`)
+		}
+		if err := p.parseExpression(); err != nil {
+			return err
+		}
+	}
+}
+
+// parseProperties parses the properties (name: value) separated by commas till end.
+func (p *blueprintParser) parseProperties(end rune) *blueprintError {
+	for {
+		if err := p.skip(); err != nil {
+			return err
+		}
+		if p.peek(0) == end {
+			p.next()
+			return nil
+		}
+		if name := p.ident(); name == "" {
+			return p.unexpected("property name")
+		}
+		if err := p.skip(); err != nil {
+			return err
+		}
+		if p.peek(0) != ':' && p.peek(0) != '=' {
+			return p.unexpected(`":"`)
+		}
+		p.next()
+		if err := p.parseExpression(); err != nil {
+			return err
+		}
+		if err := p.skip(); err != nil {
+			return err
+		}
+		if p.peek(0) == ',' {
+			p.next()
+		} else if p.peek(0) != end {
+			return p.unexpected(fmt.Sprintf(`"," or %q`, end))
+		}
+	}
+}
+
+func (p *blueprintParser) parseExpression() *blueprintError {
+	for {
+		if err := p.parseOperand(); err != nil {
+			return err
+		}
+		if err := p.skip(); err != nil {
+			return err
+		}
+		if p.peek(0) != '+' || p.peek(1) == '=' {
+			return nil
+		}
+		p.next()
+	}
+}
+
+// nolint:gocyclo
+func (p *blueprintParser) parseOperand() *blueprintError {
+	if err := p.skip(); err != nil {
+		return err
+	}
+
+	r := p.peek(0)
+
+	switch {
+	case r == '"':
+		err := p.errorf("Unclosed string")
+		p.next()
+		for p.peek(0) != '"' {
+			if p.pos >= len(p.data) || p.peek(0) == '\n' {
+				return err
+			}
+			if p.next() == '\\' && p.pos < len(p.data) {
+				p.next()
+			}
+		}
+		p.next()
+	case r == '`':
+		err := p.errorf("Unclosed string")
+		p.next()
+		for p.peek(0) != '`' {
+			if p.pos >= len(p.data) {
+				return err
+			}
+			p.next()
+		}
+		p.next()
+	case r == '-' || unicode.IsDigit(r):
+		p.next()
+		if r == '-' && !unicode.IsDigit(p.peek(0)) {
+			return p.unexpected("digit")
+		}
+		for unicode.IsDigit(p.peek(0)) {
+			p.next()
+		}
+	case r == '[':
+		p.next()
+		for {
+			if err := p.skip(); err != nil {
+				return err
+			}
+			if p.peek(0) == ']' {
+				p.next()
+				break
+			}
+			if err := p.parseExpression(); err != nil {
+				return err
+			}
+			if err := p.skip(); err != nil {
+				return err
+			}
+			if p.peek(0) == ',' {
+				p.next()
+			} else if p.peek(0) != ']' {
+				return p.unexpected(`"," or "]"`)
+			}
+		}
+	case r == '{':
+		p.next()
+		return p.parseProperties('}')
+	case r == '_' || unicode.IsLetter(r):
+		p.ident()
+		if err := p.skip(); err != nil {
+			return err
+		}
+		// Function calls (e.g., select) are checked for balanced parentheses only.
+		if p.peek(0) == '(' {
+			return p.skipCall()
+		}
+	default:
+		return p.unexpected("value")
+	}
+
+	return nil
+}
+
+func (p *blueprintParser) skipCall() *blueprintError {
+	err := p.errorf("Unclosed \"(\"")
+	depth := 0
+
+	for p.pos < len(p.data) {
+		switch p.next() {
+		case '(':
+			depth++
+		case ')':
+			if depth--; depth == 0 {
+				return nil
+			}
+		case '"':
+			for p.pos < len(p.data) && p.peek(0) != '"' {
+				if p.next() == '\\' && p.pos < len(p.data) {
+					p.next()
+				}
+			}
+			if p.pos < len(p.data) {
+				p.next()
+			}
+		}
+	}
+
+	return err
+}
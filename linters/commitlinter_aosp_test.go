@@ -0,0 +1,247 @@
+package linters
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/devops-pipeflow/insight-plugin/config"
+)
+
+const (
+	aospBlueprint = `// Copyright
+package {
+    default_applicable_licenses: ["foo_license"],
+}
+
+srcs = ["foo.c"]
+srcs += ["bar.c"]
+
+/* comment */
+cc_binary {
+    name: "foo",
+    srcs: srcs + [
+        "baz.c",
+    ],
+    cflags: ["-DFOO=\"foo\""],
+    shared_libs: select(soong_config_variable("foo", "bar"), {
+        "baz": ["libbaz"],
+        default: [],
+    }),
+    enabled: true,
+    stl: "none",
+    target: {
+        android: {
+            version: -1,
+        },
+    },
+}
+`
+	aospContexts = `# Foo
+/system/bin/foo          u:object_r:foo_exec:s0
+/data/foo(/.*)?          u:object_r:foo_data_file:s0
+/dev/foo        -c       u:object_r:foo_device:s0
+/dev/foo                 u:object_r:foo_device:s0
+/system/bin/foo          u:object_r:bar_exec:s0
+/data/bar(/.*?          u:object_r:bar_data_file:s0
+/dev/bar        -x       u:object_r:bar_device:s0
+/dev/baz                 foo_device
+/dev/qux
+/vendor/foo              <<none>>
+`
+	aospManifest = `<?xml version="1.0" encoding="utf-8"?>
+<manifest xmlns:android="http://schemas.android.com/apk/res/android" package="com.example.foo">
+    <application android:label="foo">
+        <activity android:name=".Foo" />
+    </application>
+</manifest>
+`
+	aospPolicy = `# Foo
+type foo, domain;
+type foo_exec, exec_type, file_type;
+typealias foo_exec alias { foo_bin };
+
+init_daemon_domain(foo)
+
+allow foo foo_exec:file { read open };
+allow foo self:capability net_admin;
+allow foo { bar_data_file -foo_exec }:dir search; # bar
+allow foo foo_bin:file *;
+dontaudit foo foo_exec:file ~{ read };
+allow { domain -foo } foo_exec:file execute;
+`
+)
+
+func TestLintSepolicy(t *testing.T) {
+	ctx := context.Background()
+
+	linter := initCommitLinter()
+	path := t.TempDir()
+
+	lint := func(ctx context.Context, data string, rule *config.LintRule) []string {
+		_ = os.WriteFile(filepath.Join(path, "foo.te"), []byte(data), 0o600)
+		ret, err := linter.lintSepolicy(ctx, path, []string{"foo.te"}, rule)
+		assert.Equal(t, nil, err)
+		var buf []string
+		for _, item := range ret {
+			assert.Equal(t, ruleSepolicy, item.Rule)
+			buf = append(buf, fmt.Sprintf("%d: %s: %s", item.Line, item.Severity, item.Message))
+		}
+		return buf
+	}
+
+	// The types are not checked without tree.
+	ret := lint(ctx, aospPolicy, &config.LintRule{})
+	assert.Equal(t, []string{
+		"11: Warn: Wildcard or complement in allow rule (neverallow-prone)",
+		"12: Warn: Wildcard or complement in allow rule (neverallow-prone)",
+	}, ret)
+
+	tree := fakeTree{
+		dirs: map[string][]string{
+			"public":  {"attributes", "init.te", "file.te", "README.md"},
+			"private": {"file_contexts"},
+		},
+		files: map[string]string{
+			"public/attributes": "attribute domain;\nattribute file_type; # file\nattribute exec_type;\n",
+			"public/init.te":    "type init, domain;\n",
+			"public/file.te":    "type system_data_file, file_type;\n",
+		},
+	}
+
+	ctx = context.WithValue(ctx, treeKey{}, &tree)
+
+	ret = lint(ctx, aospPolicy, &config.LintRule{})
+	assert.Equal(t, []string{
+		"10: Warn: Type bar_data_file not defined",
+		"11: Warn: Wildcard or complement in allow rule (neverallow-prone)",
+		"12: Warn: Wildcard or complement in allow rule (neverallow-prone)",
+	}, ret)
+	assert.Equal(t, []string{sepolicyPlatform + ":public", sepolicyPlatform + ":private", sepolicyPlatform + ":vendor"}, tree.listed)
+
+	ret = lint(ctx, aospPolicy, &config.LintRule{Allowlist: config.LintAllowlist{Regexes: []string{`_data_file$`}}})
+	assert.Equal(t, 2, len(ret))
+
+	ret = lint(ctx, "allow foo init:process sigchld;\n", &config.LintRule{})
+	assert.Equal(t, []string{"1: Warn: Type foo not defined"}, ret)
+
+	ret = lint(ctx, "type foo;\ninit_daemon_domain(foo\nallow foo foo:file read;\n", &config.LintRule{})
+	assert.Equal(t, []string{`2: Error: Unclosed '('`}, ret)
+
+	ret = lint(ctx, "type foo;\nallow foo { foo }}:file read;\n", &config.LintRule{})
+	assert.Equal(t, []string{`2: Error: Unbalanced '}'`}, ret)
+
+	// The types are not checked if the platform policy failed to read.
+	tree.files = nil
+
+	ret = lint(ctx, "allow foo init:process sigchld;\n", &config.LintRule{})
+	assert.Equal(t, 0, len(ret))
+
+	_, err := linter.lintSepolicy(ctx, path, []string{"foo.te"}, &config.LintRule{Allowlist: config.LintAllowlist{Regexes: []string{"[a-"}}})
+	assert.NotEqual(t, nil, err)
+}
+
+func TestLintContexts(t *testing.T) {
+	ctx := context.Background()
+
+	linter := initCommitLinter()
+	path := t.TempDir()
+
+	_ = os.WriteFile(filepath.Join(path, "file_contexts"), []byte(aospContexts), 0o600)
+
+	ret, err := linter.lintContexts(ctx, path, []string{"file_contexts"}, nil)
+	assert.Equal(t, nil, err)
+
+	var buf []string
+	for _, item := range ret {
+		assert.Equal(t, ruleContexts, item.Rule)
+		buf = append(buf, fmt.Sprintf("%d: %s", item.Line, item.Message))
+	}
+
+	assert.Equal(t, []string{
+		"6: Duplicate path /system/bin/foo (first at line 2)",
+		"7: Invalid regexp /data/bar(/.*?",
+		"8: Invalid file type -x",
+		"9: Invalid context foo_device",
+		"10: Invalid entry (expected \"path [type] context\")",
+	}, buf)
+}
+
+func TestLintBlueprint(t *testing.T) {
+	ctx := context.Background()
+
+	linter := initCommitLinter()
+	path := t.TempDir()
+
+	lint := func(data string) []string {
+		_ = os.WriteFile(filepath.Join(path, "Android.bp"), []byte(data), 0o600)
+		ret, err := linter.lintBlueprint(ctx, path, []string{"Android.bp"}, nil)
+		assert.Equal(t, nil, err)
+		var buf []string
+		for _, item := range ret {
+			assert.Equal(t, ruleBlueprint, item.Rule)
+			buf = append(buf, fmt.Sprintf("%d:%d: %s", item.Line, item.Column, item.Message))
+		}
+		return buf
+	}
+
+	ret := lint(aospBlueprint)
+	assert.Equal(t, 0, len(ret))
+
+	ret = lint("cc_binary {\n    name: \"foo\"\n    srcs: [\"foo.c\"],\n}\n")
+	assert.Equal(t, []string{`3:5: Unexpected 's' (expected "," or '}')`}, ret)
+
+	ret = lint("cc_binary {\n    name: \"foo,\n}\n")
+	assert.Equal(t, []string{"2:11: Unclosed string"}, ret)
+
+	ret = lint("cc_binary {\n    srcs: [\"foo.c\",\n")
+	assert.Equal(t, []string{`3:1: Unexpected end of file (expected value)`}, ret)
+
+	ret = lint("/* foo\n")
+	assert.Equal(t, []string{"1:1: Unclosed comment"}, ret)
+
+	ret = lint("foo: \"bar\"\n")
+	assert.Equal(t, []string{`1:4: Unexpected ':' (expected "=", "+=" or "{")`}, ret)
+}
+
+func TestLintManifest(t *testing.T) {
+	ctx := context.Background()
+
+	linter := initCommitLinter()
+	path := t.TempDir()
+
+	lint := func(data string) []string {
+		_ = os.WriteFile(filepath.Join(path, "AndroidManifest.xml"), []byte(data), 0o600)
+		ret, err := linter.lintManifest(ctx, path, []string{"AndroidManifest.xml"}, nil)
+		assert.Equal(t, nil, err)
+		var buf []string
+		for _, item := range ret {
+			assert.Equal(t, ruleManifest, item.Rule)
+			buf = append(buf, fmt.Sprintf("%d: %s", item.Line, item.Message))
+		}
+		return buf
+	}
+
+	ret := lint(aospManifest)
+	assert.Equal(t, 0, len(ret))
+
+	ret = lint("<manifest package=\"foo\">\n</manifest>\n")
+	assert.Equal(t, []string{"1: Namespace android not declared"}, ret)
+
+	ret = lint("<resources>\n</resources>\n")
+	assert.Equal(t, []string{"1: Root element resources not manifest"}, ret)
+
+	ret = lint("<manifest xmlns:android=\"http://schemas.android.com/apk/res/android\">\n<application/>\n<application/>\n</manifest>\n")
+	assert.Equal(t, []string{"3: Multiple application elements found"}, ret)
+
+	ret = lint("<manifest xmlns:android=\"http://schemas.android.com/apk/res/android\">\n<application>\n</manifest>\n")
+	assert.Equal(t, 1, len(ret))
+	assert.Equal(t, "3: XML syntax error on line 3: element <application> closed by </manifest>", ret[0])
+
+	ret = lint("")
+	assert.Equal(t, []string{"0: Root element manifest not found"}, ret)
+}
@@ -3,8 +3,8 @@ package linters
 import (
 	"context"
 	"fmt"
+	"os"
 	"path/filepath"
-	"strconv"
 	"strings"
 	"testing"
 
@@ -14,10 +14,6 @@ import (
 	"github.com/devops-pipeflow/insight-plugin/config"
 )
 
-const (
-	commitSep = ":"
-)
-
 var (
 	commitPath = filepath.Join("..", "test", "linters")
 )
@@ -36,22 +32,235 @@ func initCommitLinter() commitlinter {
 	return cl
 }
 
+func TestCommitLinterRun(t *testing.T) {
+	ctx := context.Background()
+
+	linter := initCommitLinter()
+	_ = linter.Init(ctx)
+
+	files := []string{"commit.conflict", "commit.json", "commit.te", "commit.xml"}
+
+	ret, err := linter.Run(ctx, commitPath, files, nil)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 4, len(ret))
+	assert.Equal(t, []string{ruleConflict, ruleJson, ruleNewline, ruleXml},
+		[]string{ret[0].Rule, ret[1].Rule, ret[2].Rule, ret[3].Rule})
+
+	rules := []config.LintRule{
+		{Name: ruleConflict, Disabled: true},
+		{Name: ruleJson, Disabled: true},
+		{Name: ruleJson},
+		{Name: ruleNewline, Extensions: []string{".xml"}},
+	}
+
+	ret, err = linter.Run(ctx, commitPath, files, rules)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, len(ret))
+	assert.Equal(t, ruleJson, ret[0].Rule)
+	assert.Equal(t, ruleXml, ret[1].Rule)
+
+	ret, err = linter.Run(ctx, commitPath, []string{"invalid.json", "commit.te"}, nil)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 4, len(ret))
+	assert.Equal(t, ruleConflict, ret[0].Rule)
+	assert.Equal(t, ruleFile, ret[1].Rule)
+	assert.Equal(t, ruleJson, ret[2].Rule)
+	assert.Equal(t, ruleNewline, ret[3].Rule)
+}
+
+func TestCommitLinterRegister(t *testing.T) {
+	ctx := context.Background()
+
+	linter := initCommitLinter()
+	_ = linter.Init(ctx)
+
+	count := len(linter.rules)
+
+	linter.register(config.LintRule{Name: ruleJson, Extensions: []string{".json5"}}, linter.lintJson)
+	assert.Equal(t, count, len(linter.rules))
+	assert.Equal(t, ruleJson, linter.rules[5].rule.Name)
+	assert.Equal(t, []string{".json5"}, linter.rules[5].rule.Extensions)
+
+	linter.register(config.LintRule{Name: "custom"}, linter.lintNewline)
+	assert.Equal(t, count+1, len(linter.rules))
+	assert.Equal(t, "custom", linter.rules[count].rule.Name)
+}
+
 func TestLintConflict(t *testing.T) {
 	ctx := context.Background()
 
 	linter := initCommitLinter()
 	files := []string{"commit.conflict"}
 
-	ret, err := linter.lintConflict(ctx, commitPath, files)
+	ret, err := linter.lintConflict(ctx, commitPath, files, nil)
 	assert.Equal(t, nil, err)
 	assert.Equal(t, 1, len(ret))
 
-	buf := strings.Split(ret[0], commitSep)
-	assert.Equal(t, "commit.conflict", buf[0])
-	line, _ := strconv.Atoi(buf[1])
-	assert.Equal(t, 0, line)
-	assert.Equal(t, "Error", buf[2])
-	assert.Equal(t, "Conflict character found", buf[3])
+	assert.Equal(t, "commit.conflict", ret[0].File)
+	assert.Equal(t, 0, ret[0].Line)
+	assert.Equal(t, SeverityError, ret[0].Severity)
+	assert.Equal(t, "Conflict character found", ret[0].Message)
+	assert.Equal(t, linterCommit, ret[0].Linter)
+	assert.Equal(t, ruleConflict, ret[0].Rule)
+}
+
+func TestLintFile(t *testing.T) {
+	ctx := context.Background()
+
+	linter := initCommitLinter()
+	path := t.TempDir()
+
+	_ = os.MkdirAll(filepath.Join(path, "prebuilt"), 0o700)
+	_ = os.WriteFile(filepath.Join(path, "foo.c"), []byte("int foo;\n"), 0o600)
+	_ = os.WriteFile(filepath.Join(path, "Foo.c"), []byte("int foo;\n"), 0o600)
+	_ = os.WriteFile(filepath.Join(path, "foo.txt"), []byte("\x7fELF\x02\x01\x01\x00\x00\x00"), 0o600)
+	_ = os.WriteFile(filepath.Join(path, "foo.png"), []byte("\x89PNG\r\n\x1a\n"), 0o600)
+	_ = os.WriteFile(filepath.Join(path, "prebuilt", "foo.so"), []byte("\x7fELF\x02\x01\x01\x00"), 0o600)
+	_ = os.WriteFile(filepath.Join(path, "foo.json"), []byte(strings.Repeat("{}", 100)), 0o600)
+
+	rule := config.LintRule{SizeMax: 100, Denylist: fileDenylist, IllegalChars: fileChars}
+
+	lint := func(files []string, rule *config.LintRule) []string {
+		ret, err := linter.lintFile(ctx, path, files, rule)
+		assert.Equal(t, nil, err)
+		var buf []string
+		for _, item := range ret {
+			assert.Equal(t, ruleFile, item.Rule)
+			buf = append(buf, item.File+": "+item.Message)
+		}
+		return buf
+	}
+
+	ret := lint([]string{"foo.c", "Foo.c", "foo.txt", "foo.png", "prebuilt/foo.so", "foo.json"}, &rule)
+	assert.Equal(t, []string{
+		"Foo.c: Path collides case-insensitively with foo.c",
+		"foo.txt: Binary file found (application/octet-stream)",
+		"foo.png: Binary file found (image/png)",
+		"prebuilt/foo.so: Extension .so not allowed",
+		"prebuilt/foo.so: Binary file found (application/octet-stream)",
+		"foo.json: File larger than 100 bytes (found 200)",
+	}, ret)
+
+	rule.Allowlist.Paths = []string{`^prebuilt/`}
+
+	ret = lint([]string{"prebuilt/foo.so"}, &rule)
+	assert.Equal(t, 0, len(ret))
+
+	_ = os.WriteFile(filepath.Join(path, "readme"), []byte("foo\n"), 0o600)
+	_ = os.MkdirAll(filepath.Join(path, "src"), 0o700)
+	_ = os.WriteFile(filepath.Join(path, "src", "bar.c"), []byte("int bar;\n"), 0o600)
+
+	ret = lint([]string{"readme", "src/bar.c"}, &rule)
+	assert.Equal(t, 0, len(ret))
+
+	tree := fakeTree{project: "foo", dirs: map[string][]string{
+		"":    {"README", "Src", "readme"},
+		"Src": {"bar.c"},
+	}}
+
+	ctx = context.WithValue(ctx, treeKey{}, &tree)
+
+	ret = lint([]string{"readme", "src/bar.c"}, &rule)
+	assert.Equal(t, []string{
+		"readme: Path collides case-insensitively with README",
+		"src/bar.c: Path collides case-insensitively with Src",
+	}, ret)
+	assert.Equal(t, []string{"foo:"}, tree.listed)
+
+	ret = lint([]string{"foo:bar.c"}, &rule)
+	assert.Equal(t, 2, len(ret))
+	assert.Equal(t, `foo:bar.c: Path name with illegal character ':'`, ret[0])
+
+	rule.Allowlist.Paths = []string{"[a-"}
+
+	_, err := linter.lintFile(ctx, path, []string{"foo.c"}, &rule)
+	assert.NotEqual(t, nil, err)
+}
+
+type fakeTree struct {
+	project string
+	dirs    map[string][]string
+	files   map[string]string
+	listed  []string
+}
+
+func (t *fakeTree) Project() string {
+	return t.project
+}
+
+func (t *fakeTree) List(_ context.Context, project, dir string) ([]string, error) {
+	t.listed = append(t.listed, project+":"+dir)
+	if entries, ok := t.dirs[dir]; ok {
+		return entries, nil
+	}
+	return nil, fmt.Errorf("%s not found", dir)
+}
+
+func (t *fakeTree) Read(_ context.Context, _, file string) ([]byte, error) {
+	if data, ok := t.files[file]; ok {
+		return []byte(data), nil
+	}
+	return nil, fmt.Errorf("%s not found", file)
+}
+
+func TestCheckPath(t *testing.T) {
+	assert.Equal(t, "", checkPath("foo/bar.c", fileChars))
+	assert.Equal(t, "", checkPath("../foo/.bar", fileChars))
+	assert.Equal(t, `Path name with illegal character '?'`, checkPath("foo?.c", fileChars))
+	assert.Equal(t, `Path name with illegal character '\t'`, checkPath("foo\t.c", fileChars))
+	assert.Equal(t, "Path name ends with space or period", checkPath("foo /bar.c", fileChars))
+	assert.Equal(t, "Path name ends with space or period", checkPath("foo.", fileChars))
+	assert.Equal(t, "Path name aux.c reserved on Windows", checkPath("foo/aux.c", fileChars))
+	assert.Equal(t, "Path name not in UTF-8", checkPath("foo\xff.c", fileChars))
+	assert.Equal(t, "", checkPath("foo?.c", "#"))
+}
+
+func TestLintIni(t *testing.T) {
+	ctx := context.Background()
+
+	linter := initCommitLinter()
+	files := []string{"commit.ini"}
+
+	ret, err := linter.lintIni(ctx, commitPath, files, nil)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(ret))
+
+	assert.Equal(t, "commit.ini", ret[0].File)
+	assert.Equal(t, 4, ret[0].Line)
+	assert.Equal(t, 17, ret[0].Column)
+	assert.Equal(t, SeverityError, ret[0].Severity)
+	assert.Equal(t, "Unclosed section header", ret[0].Message)
+}
+
+func TestParseIni(t *testing.T) {
+	format := func(line, column int, message string) string {
+		return fmt.Sprintf("%d:%d: %s", line, column, message)
+	}
+
+	assert.Equal(t, "0:0: ", format(parseIni("; foo\n[foo]\nbar = baz\n  qux\n[bar] # bar\nbaz: qux\n")))
+	assert.Equal(t, "2:3: Missing \"=\" in key-value pair", format(parseIni("[foo]\n  bar\n")))
+	assert.Equal(t, "1:1: Empty section name", format(parseIni("[ ]\n")))
+	assert.Equal(t, "1:6: Unexpected characters after section header", format(parseIni("[foo]bar\n")))
+	assert.Equal(t, "2:1: Empty key", format(parseIni("[foo]\n= bar\n")))
+}
+
+func TestOffsetPosition(t *testing.T) {
+	data := []byte("foo\n测试bar\n")
+
+	line, column := offsetPosition(data, 0)
+	assert.Equal(t, []int{1, 1}, []int{line, column})
+
+	line, column = offsetPosition(data, 4)
+	assert.Equal(t, []int{2, 1}, []int{line, column})
+
+	line, column = offsetPosition(data, 10)
+	assert.Equal(t, []int{2, 3}, []int{line, column})
+
+	line, column = offsetPosition(data, 100)
+	assert.Equal(t, []int{3, 1}, []int{line, column})
+
+	line, column = offsetPosition(data, -1)
+	assert.Equal(t, []int{1, 1}, []int{line, column})
 }
 
 func TestLintJson(t *testing.T) {
@@ -60,16 +269,15 @@ func TestLintJson(t *testing.T) {
 	linter := initCommitLinter()
 	files := []string{"commit.json"}
 
-	ret, err := linter.lintJson(ctx, commitPath, files)
+	ret, err := linter.lintJson(ctx, commitPath, files, nil)
 	assert.Equal(t, nil, err)
 	assert.Equal(t, 1, len(ret))
 
-	buf := strings.Split(ret[0], commitSep)
-	assert.Equal(t, "commit.json", buf[0])
-	line, _ := strconv.Atoi(buf[1])
-	assert.Equal(t, 0, line)
-	assert.Equal(t, "Error", buf[2])
-	assert.NotEqual(t, 0, len(buf[3]))
+	assert.Equal(t, "commit.json", ret[0].File)
+	assert.Equal(t, 8, ret[0].Line)
+	assert.Equal(t, 26, ret[0].Column)
+	assert.Equal(t, SeverityError, ret[0].Severity)
+	assert.NotEqual(t, 0, len(ret[0].Message))
 }
 
 func TestLintMessage(t *testing.T) {
@@ -78,43 +286,131 @@ func TestLintMessage(t *testing.T) {
 	linter := initCommitLinter()
 	files := []string{"commit.message"}
 
-	ret, err := linter.lintMessage(ctx, commitPath, files)
+	rule := config.LintRule{SubjectMin: subjectMin, SubjectMax: subjectMax, DescriptionMax: descriptionMax}
+
+	ret, err := linter.lintMessage(ctx, commitPath, files, &rule)
 	assert.Equal(t, nil, err)
 	assert.Equal(t, 2, len(ret))
 
-	buf := strings.Split(ret[0], commitSep)
-	assert.Equal(t, messageName, buf[0])
-	line, _ := strconv.Atoi(buf[1])
-	assert.Equal(t, 0, line)
-	assert.Equal(t, "Error", buf[2])
-	r := strings.Contains(buf[3], fmt.Sprintf("Subject shorter than %d characters", subjectMin))
+	assert.Equal(t, messageName, ret[0].File)
+	assert.Equal(t, 1, ret[0].Line)
+	assert.Equal(t, SeverityError, ret[0].Severity)
+	r := strings.Contains(ret[0].Message, fmt.Sprintf("Subject shorter than %d characters (found 20)", subjectMin))
 	assert.Equal(t, true, r)
 
-	buf = strings.Split(ret[1], commitSep)
-	assert.Equal(t, messageName, buf[0])
-	line, _ = strconv.Atoi(buf[1])
-	assert.Equal(t, 0, line)
-	assert.Equal(t, "Error", buf[2])
-	r = strings.Contains(buf[3], fmt.Sprintf("Description longer than %d characters", subjectMax))
+	assert.Equal(t, messageName, ret[1].File)
+	assert.Equal(t, 3, ret[1].Line)
+	assert.Equal(t, SeverityError, ret[1].Severity)
+	r = strings.Contains(ret[1].Message, fmt.Sprintf("Description longer than %d characters", subjectMax))
 	assert.Equal(t, true, r)
 }
 
+func TestLintMessagePolicy(t *testing.T) {
+	ctx := context.Background()
+
+	linter := initCommitLinter()
+	path := t.TempDir()
+
+	rule := config.LintRule{SubjectMin: 10, SubjectMax: 20, DescriptionMax: 20}
+
+	lint := func(data string, rule *config.LintRule) []string {
+		_ = os.WriteFile(filepath.Join(path, messageFile), []byte(data), 0o600)
+		ret, err := linter.lintMessage(ctx, path, []string{messageFile}, rule)
+		assert.Equal(t, nil, err)
+		var buf []string
+		for _, item := range ret {
+			buf = append(buf, fmt.Sprintf("%d: %s", item.Line, item.Message))
+		}
+		return buf
+	}
+
+	changeId := "Change-Id: I0123456789abcdef0123456789abcdef01234567\n"
+
+	ret := lint("Add foo to bar\n\nFoo bar.\n\n"+changeId, &rule)
+	assert.Equal(t, 0, len(ret))
+
+	ret = lint("Parent: 0123456\nAuthor: foo <foo@example.com>\n\nAdd foo to bar\n\n"+changeId, &rule)
+	assert.Equal(t, 0, len(ret))
+
+	ret = lint("Add foo to bar\n", &rule)
+	assert.Equal(t, []string{"0: Change-Id not found"}, ret)
+
+	ret = lint("Add foo to bar\n\nChange-Id: I0123\n", &rule)
+	assert.Equal(t, []string{`3: Invalid Change-Id (found "Change-Id: I0123")`}, ret)
+
+	ret = lint("添加模块到构建系统里面了\n\n"+changeId, &rule)
+	assert.Equal(t, []string{"1: Subject longer than 20 characters (found 24)"}, ret)
+
+	ret = lint("[WIP] Add foo to bar.\nFoo bar\n"+changeId, &rule)
+	assert.Equal(t, []string{
+		"1: Subject longer than 20 characters (found 21)",
+		"1: Subject ends with a period",
+		"1: Subject marked as work in progress",
+		"2: No blank line after subject",
+	}, ret)
+
+	ret = lint("\n", &rule)
+	assert.Equal(t, []string{"0: Empty message"}, ret)
+
+	style := rule
+	style.SubjectStyle = config.StyleConventional
+	style.Trailers = []string{"Bug", "Signed-off-by"}
+
+	ret = lint("fix(foo): add bar\n\nBug: 123\n"+changeId, &style)
+	assert.Equal(t, []string{"0: Trailer Signed-off-by not found"}, ret)
+
+	ret = lint("Add foo to bar\n\nBug: 123\nSigned-off-by: foo\n"+changeId, &style)
+	assert.Equal(t, 1, len(ret))
+	assert.Equal(t, true, strings.HasPrefix(ret[0], "1: Subject not in Conventional Commits"))
+
+	style.SubjectStyle = config.StyleModule
+	style.Trailers = nil
+
+	ret = lint("[foo] Add bar\n\n"+changeId, &style)
+	assert.Equal(t, 0, len(ret))
+
+	ret = lint("foo: Add bar\n\n"+changeId, &style)
+	assert.Equal(t, 1, len(ret))
+}
+
+func TestDisplayWidth(t *testing.T) {
+	assert.Equal(t, 0, displayWidth(""))
+	assert.Equal(t, 3, displayWidth("foo"))
+	assert.Equal(t, 4, displayWidth("测试"))
+	assert.Equal(t, 6, displayWidth("foo测a"))
+}
+
 func TestLintNewline(t *testing.T) {
 	ctx := context.Background()
 
 	linter := initCommitLinter()
 	files := []string{"commit.te"}
 
-	ret, err := linter.lintNewline(ctx, commitPath, files)
+	ret, err := linter.lintNewline(ctx, commitPath, files, nil)
 	assert.Equal(t, nil, err)
 	assert.Equal(t, 1, len(ret))
 
-	buf := strings.Split(ret[0], commitSep)
-	assert.Equal(t, "commit.te", buf[0])
-	line, _ := strconv.Atoi(buf[1])
-	assert.Equal(t, 0, line)
-	assert.Equal(t, "Error", buf[2])
-	assert.Equal(t, "No newline at end of file", buf[3])
+	assert.Equal(t, "commit.te", ret[0].File)
+	assert.Equal(t, 0, ret[0].Line)
+	assert.Equal(t, SeverityError, ret[0].Severity)
+	assert.Equal(t, "No newline at end of file", ret[0].Message)
+}
+
+func TestLintToml(t *testing.T) {
+	ctx := context.Background()
+
+	linter := initCommitLinter()
+	files := []string{"commit.toml"}
+
+	ret, err := linter.lintToml(ctx, commitPath, files, nil)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(ret))
+
+	assert.Equal(t, "commit.toml", ret[0].File)
+	assert.Equal(t, 6, ret[0].Line)
+	assert.NotEqual(t, 0, ret[0].Column)
+	assert.Equal(t, SeverityError, ret[0].Severity)
+	assert.NotEqual(t, 0, len(ret[0].Message))
 }
 
 func TestLintXml(t *testing.T) {
@@ -123,14 +419,61 @@ func TestLintXml(t *testing.T) {
 	linter := initCommitLinter()
 	files := []string{"commit.xml"}
 
-	ret, err := linter.lintXml(ctx, commitPath, files)
+	ret, err := linter.lintXml(ctx, commitPath, files, nil)
 	assert.Equal(t, nil, err)
 	assert.Equal(t, 1, len(ret))
 
-	buf := strings.Split(ret[0], commitSep)
-	assert.Equal(t, "commit.xml", buf[0])
-	line, _ := strconv.Atoi(buf[1])
+	assert.Equal(t, "commit.xml", ret[0].File)
+	assert.Equal(t, 3, ret[0].Line)
+	assert.Equal(t, 106, ret[0].Column)
+	assert.Equal(t, SeverityError, ret[0].Severity)
+	assert.NotEqual(t, 0, len(ret[0].Message))
+}
+
+func TestLintYaml(t *testing.T) {
+	ctx := context.Background()
+
+	linter := initCommitLinter()
+	files := []string{"commit.yaml"}
+
+	ret, err := linter.lintYaml(ctx, commitPath, files, nil)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(ret))
+
+	assert.Equal(t, "commit.yaml", ret[0].File)
+	assert.Equal(t, 7, ret[0].Line)
+	assert.Equal(t, SeverityError, ret[0].Severity)
+	assert.Equal(t, "yaml: line 7: did not find expected ',' or ']'", ret[0].Message)
+
+	path := t.TempDir()
+	_ = os.WriteFile(filepath.Join(path, "foo.yml"), []byte("foo: bar\n---\nfoo: bar\nfoo: baz\n"), 0o600)
+
+	ret, err = linter.lintYaml(ctx, path, []string{"foo.yml"}, nil)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(ret))
+	assert.Equal(t, 4, ret[0].Line)
+	assert.Equal(t, 1, ret[0].Column)
+
+	_ = os.WriteFile(filepath.Join(path, "foo.yml"), []byte("foo:\n  bar: {a: 1, b: 2, a: 3}\n"), 0o600)
+
+	ret, err = linter.lintYaml(ctx, path, []string{"foo.yml"}, nil)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(ret))
+	assert.Equal(t, 2, ret[0].Line)
+	assert.Equal(t, 21, ret[0].Column)
+}
+
+func TestParseYaml(t *testing.T) {
+	line, column, message := parseYaml([]byte("foo: bar\n---\nbar: baz\n"))
 	assert.Equal(t, 0, line)
-	assert.Equal(t, "Error", buf[2])
-	assert.NotEqual(t, 0, len(buf[3]))
+	assert.Equal(t, 0, column)
+	assert.Equal(t, "", message)
+
+	line, column, _ = parseYaml([]byte("foo:\n  - bar: 1\n    bar: 2\n"))
+	assert.Equal(t, 3, line)
+	assert.Equal(t, 5, column)
+
+	line, column, _ = parseYaml([]byte("foo: bar\n\tbaz: 1\n"))
+	assert.Equal(t, 2, line)
+	assert.Equal(t, 0, column)
 }
@@ -1,26 +1,76 @@
 package linters
 
 import (
+	"bytes"
 	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"text/template"
 
 	"github.com/hashicorp/go-hclog"
+	"github.com/pkg/errors"
+	"github.com/reviewdog/reviewdog/diff"
 
 	"github.com/devops-pipeflow/insight-plugin/config"
+	"github.com/devops-pipeflow/insight-plugin/gpt"
+)
+
+const (
+	ruleReview = "review"
+)
+
+const (
+	linterGpt = "gptlinter"
+)
+
+const (
+	gptMessage   = "COMMIT_MSG"
+	gptPrefix    = "b/"
+	gptRatio     = 4
+	gptTokens    = 2000
+	gptTruncated = "... (truncated)\n"
+)
+
+const (
+	gptPrompt = `You are an experienced code reviewer. Review the change of {{.File}} below,
+report the bugs, security issues and obvious performance or readability problems on the added lines only.
+
+Each line of the change is prefixed with its line number, "+" for added lines and "-" for deleted lines.
+Reply one finding per line in the format "LINE: SEVERITY: MESSAGE", where LINE is the line number of
+an added line and SEVERITY is one of Error, Warn and Info. Reply "LGTM" if nothing is found.
+
+{{.Diff}}`
+)
+
+var (
+	gptReply = regexp.MustCompile(`(?i)^\s*(\d+)\s*:\s*(error|warning|warn|info)\s*:\s*(.+)$`)
 )
 
 type GptLinter interface {
 	Init(context.Context) error
 	Deinit(context.Context) error
-	Run(context.Context, string, []string) ([]string, error)
+	Run(context.Context, string, []string, []config.LintRule) ([]Finding, error)
+	RunPatch(context.Context, string, []string, []config.LintRule, []byte) ([]Finding, error)
 }
 
 type GptLinterConfig struct {
 	Config config.Config
 	Logger hclog.Logger
+	Gpt    gpt.Gpt
 }
 
 type gptlinter struct {
-	cfg *GptLinterConfig
+	cfg  *GptLinterConfig
+	rule config.LintRule
+}
+
+type gptPromptData struct {
+	File string
+	Diff string
 }
 
 func GptLinterNew(_ context.Context, cfg *GptLinterConfig) GptLinter {
@@ -33,26 +83,196 @@ func DefaultGptLinterConfig() *GptLinterConfig {
 	return &GptLinterConfig{}
 }
 
-func (gl *gptlinter) Init(_ context.Context) error {
+func (gl *gptlinter) Init(ctx context.Context) error {
 	gl.cfg.Logger.Debug("gptlinter: Init")
 
-	// TBD: FIXME
+	// The prompt and tokens are overridden per project by the rules of LintConfigs.
+	gl.rule = config.LintRule{
+		Name:   ruleReview,
+		Prompt: gptPrompt,
+		Tokens: gptTokens,
+	}
+
+	if err := gl.cfg.Gpt.Init(ctx); err != nil {
+		return errors.Wrap(err, "failed to init gpt")
+	}
 
 	return nil
 }
 
-func (gl *gptlinter) Deinit(_ context.Context) error {
+func (gl *gptlinter) Deinit(ctx context.Context) error {
 	gl.cfg.Logger.Debug("gptlinter: Deinit")
 
-	// TBD: FIXME
+	_ = gl.cfg.Gpt.Deinit(ctx)
 
 	return nil
 }
 
-func (gl *gptlinter) Run(ctx context.Context, path string, files []string) ([]string, error) {
+// Run reviews the files by gpt as added as a whole, see RunPatch.
+func (gl *gptlinter) Run(ctx context.Context, path string, files []string, rules []config.LintRule) ([]Finding, error) {
 	gl.cfg.Logger.Debug("gptlinter: Run")
 
-	// TBD: FIXME
+	return gl.RunPatch(ctx, path, files, rules, nil)
+}
+
+// RunPatch reviews the changed hunks of the files by gpt with the prompt and tokens of rule, the
+// hunks are taken from the patch of the change (e.g., fetched by review), or the whole file is
+// taken as added if the patch is empty.
+func (gl *gptlinter) RunPatch(ctx context.Context, path string, files []string, rules []config.LintRule,
+	patch []byte) ([]Finding, error) {
+	gl.cfg.Logger.Debug("gptlinter: RunPatch")
+
+	rule := buildRule(&gl.rule, rules)
+	if rule.Disabled {
+		return nil, nil
+	}
+
+	t, err := template.New("prompt").Parse(rule.Prompt)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid prompt of "+rule.Name)
+	}
+
+	files = matchRule(&rule, files)
+
+	hunks, err := gl.loadHunks(path, files, patch)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to load hunks")
+	}
+
+	var buf []Finding
+
+	for _, item := range files {
+		if len(hunks[item]) == 0 {
+			continue
+		}
+		data, added := gl.buildDiff(hunks[item], rule.Tokens)
+		var prompt bytes.Buffer
+		if err := t.Execute(&prompt, gptPromptData{File: item, Diff: data}); err != nil {
+			return buf, errors.Wrap(err, "failed to build prompt")
+		}
+		ret, err := gl.cfg.Gpt.Run(ctx, prompt.String())
+		if err != nil {
+			return buf, errors.Wrap(err, "failed to run gpt")
+		}
+		buf = append(buf, gl.parseReply(item, ret, added)...)
+	}
+
+	return buf, nil
+}
+
+// loadHunks returns the hunks per file in patch, or the files as added if the patch is empty.
+// The commit message and the binary files are skipped.
+func (gl *gptlinter) loadHunks(path string, files []string, patch []byte) (map[string][]*diff.Hunk, error) {
+	gl.cfg.Logger.Debug("gptlinter: loadHunks")
+
+	buf := map[string][]*diff.Hunk{}
+
+	if len(patch) != 0 {
+		diffs, err := diff.ParseMultiFile(bytes.NewReader(patch))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse")
+		}
+		for _, item := range diffs {
+			buf[strings.TrimPrefix(item.PathNew, gptPrefix)] = item.Hunks
+		}
+		return buf, nil
+	}
+
+	for _, item := range files {
+		if filepath.Base(item) == gptMessage {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(path, item))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read")
+		}
+		if bytes.IndexByte(data, 0) >= 0 {
+			continue
+		}
+		lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+		hunk := &diff.Hunk{StartLineNew: 1, LineLengthNew: len(lines)}
+		for i, line := range lines {
+			hunk.Lines = append(hunk.Lines, &diff.Line{Type: diff.LineAdded, Content: line, LnumNew: i + 1})
+		}
+		buf[item] = []*diff.Hunk{hunk}
+	}
+
+	return buf, nil
+}
+
+// buildDiff renders the hunks with line numbers within the token budget of a file, the hunks
+// exceeding the budget are truncated. The added lines rendered are returned for the findings.
+func (gl *gptlinter) buildDiff(hunks []*diff.Hunk, tokens int64) (string, map[int]bool) {
+	gl.cfg.Logger.Debug("gptlinter: buildDiff")
+
+	budget := int(tokens) * gptRatio
+
+	var buf strings.Builder
+
+	added := map[int]bool{}
+
+	for _, hunk := range hunks {
+		header := fmt.Sprintf("@@ -%d,%d +%d,%d @@\n", hunk.StartLineOld, hunk.LineLengthOld, hunk.StartLineNew, hunk.LineLengthNew)
+		if buf.Len()+len(header) > budget {
+			buf.WriteString(gptTruncated)
+			return buf.String(), added
+		}
+		buf.WriteString(header)
+		for _, line := range hunk.Lines {
+			var data string
+			switch line.Type {
+			case diff.LineAdded:
+				data = strconv.Itoa(line.LnumNew) + " +" + line.Content + "\n"
+			case diff.LineDeleted:
+				data = "-" + line.Content + "\n"
+			default:
+				data = strconv.Itoa(line.LnumNew) + "  " + line.Content + "\n"
+			}
+			if buf.Len()+len(data) > budget {
+				buf.WriteString(gptTruncated)
+				return buf.String(), added
+			}
+			buf.WriteString(data)
+			if line.Type == diff.LineAdded {
+				added[line.LnumNew] = true
+			}
+		}
+	}
+
+	return buf.String(), added
+}
+
+// parseReply returns the findings in the reply of gpt, the ones not on the added lines are dropped.
+func (gl *gptlinter) parseReply(file, reply string, added map[int]bool) []Finding {
+	gl.cfg.Logger.Debug("gptlinter: parseReply")
+
+	severities := map[string]string{
+		"error":   SeverityError,
+		"info":    SeverityInfo,
+		"warn":    SeverityWarn,
+		"warning": SeverityWarn,
+	}
+
+	var buf []Finding
+
+	for _, item := range strings.Split(reply, "\n") {
+		match := gptReply.FindStringSubmatch(item)
+		if match == nil {
+			continue
+		}
+		line, _ := strconv.Atoi(match[1])
+		if !added[line] {
+			continue
+		}
+		buf = append(buf, Finding{
+			Linter:   linterGpt,
+			Rule:     ruleReview,
+			Severity: severities[strings.ToLower(match[2])],
+			File:     file,
+			Line:     line,
+			Message:  strings.TrimSpace(match[3]),
+		})
+	}
 
-	return nil, nil
+	return buf
 }
@@ -1,14 +1,51 @@
 package linters
 
 import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/go-hclog"
+	"github.com/reviewdog/reviewdog/diff"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/devops-pipeflow/insight-plugin/config"
 )
 
+const (
+	gptPatch = `diff --git a/foo.c b/foo.c
+index 0123456..789abcd 100644
+--- a/foo.c
++++ b/foo.c
+@@ -1,3 +1,4 @@
+ int foo;
+-int bar;
++int bar = 1;
++char *baz = malloc(1);
+ int qux;
+`
+)
+
+type fakeGpt struct {
+	prompts []string
+	ret     string
+}
+
+func (g *fakeGpt) Init(_ context.Context) error {
+	return nil
+}
+
+func (g *fakeGpt) Deinit(_ context.Context) error {
+	return nil
+}
+
+func (g *fakeGpt) Run(_ context.Context, prompt string) (string, error) {
+	g.prompts = append(g.prompts, prompt)
+	return g.ret, nil
+}
+
 func initGptLinter() gptlinter {
 	gl := gptlinter{
 		cfg: DefaultGptLinterConfig(),
@@ -19,14 +56,113 @@ func initGptLinter() gptlinter {
 		Name:  "gptlinter",
 		Level: hclog.LevelFromString("INFO"),
 	})
+	gl.cfg.Gpt = &fakeGpt{}
 
 	return gl
 }
 
+func TestGptLinterInit(t *testing.T) {
+	ctx := context.Background()
+	gl := initGptLinter()
+
+	err := gl.Init(ctx)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, gptPrompt, gl.rule.Prompt)
+	assert.Equal(t, int64(gptTokens), gl.rule.Tokens)
+}
+
 func TestGptLinterRun(t *testing.T) {
-	_ = initGptLinter()
+	ctx := context.Background()
+	gl := initGptLinter()
+
+	g := &fakeGpt{ret: "3: Error: malloc not checked\n2: warning: bar\n4: Info: qux\nLGTM"}
+	gl.cfg.Gpt = g
+	_ = gl.Init(ctx)
+
+	path := t.TempDir()
+	_ = os.WriteFile(filepath.Join(path, "foo.c"), []byte("int foo;\nint bar = 1;\nchar *baz = malloc(1);\nint qux;\n"), 0o600)
+
+	rules := []config.LintRule{{Name: ruleReview, Prompt: "Review {{.File}} in Go\n{{.Diff}}"}}
+
+	ret, err := gl.RunPatch(ctx, path, []string{"foo.c", "COMMIT_MSG"}, rules, []byte(gptPatch))
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(g.prompts))
+	assert.Equal(t, true, strings.HasPrefix(g.prompts[0], "Review foo.c in Go"))
+	assert.Equal(t, true, strings.Contains(g.prompts[0], "3 +char *baz = malloc(1);"))
+	assert.Equal(t, 2, len(ret))
+	assert.Equal(t, Finding{Linter: linterGpt, Rule: ruleReview, Severity: SeverityError, File: "foo.c", Line: 3,
+		Message: "malloc not checked"}, ret[0])
+	assert.Equal(t, SeverityWarn, ret[1].Severity)
+	assert.Equal(t, 2, ret[1].Line)
+
+	rules = []config.LintRule{{Name: ruleReview, Prompt: "Review {{.File}} in C\n{{.Diff}}", Tokens: 4}}
+
+	_, err = gl.RunPatch(ctx, path, []string{"foo.c"}, rules, []byte(gptPatch))
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, len(g.prompts))
+	assert.Equal(t, true, strings.HasPrefix(g.prompts[1], "Review foo.c in C"))
+	assert.Equal(t, true, strings.HasSuffix(g.prompts[1], gptTruncated))
+
+	ret, err = gl.Run(ctx, path, []string{"foo.c"}, []config.LintRule{{Name: ruleReview, Extensions: []string{".go"}}})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(ret))
+	assert.Equal(t, 2, len(g.prompts))
+
+	_, err = gl.Run(ctx, path, []string{"foo.c"}, []config.LintRule{{Name: ruleReview, Prompt: "{{.File"}})
+	assert.NotEqual(t, nil, err)
+}
+
+func TestGptLinterLoadHunks(t *testing.T) {
+	gl := initGptLinter()
+
+	path := t.TempDir()
+	_ = os.WriteFile(filepath.Join(path, "foo.c"), []byte("int foo;\nint bar;\n"), 0o600)
+	_ = os.WriteFile(filepath.Join(path, "foo.bin"), []byte{0x7f, 0x00, 0x01}, 0o600)
+	_ = os.WriteFile(filepath.Join(path, "COMMIT_MSG"), []byte("Add foo\n"), 0o600)
+
+	ret, err := gl.loadHunks(path, []string{"foo.c", "foo.bin", "COMMIT_MSG"}, nil)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(ret))
+	assert.Equal(t, 2, len(ret["foo.c"][0].Lines))
+	assert.Equal(t, diff.LineAdded, ret["foo.c"][0].Lines[1].Type)
+	assert.Equal(t, 2, ret["foo.c"][0].Lines[1].LnumNew)
+
+	_, err = gl.loadHunks(path, []string{"bar.c"}, nil)
+	assert.NotEqual(t, nil, err)
+
+	ret, err = gl.loadHunks(path, []string{"bar.c"}, []byte(gptPatch))
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(ret))
+	assert.Equal(t, 1, len(ret["foo.c"]))
+}
+
+func TestGptLinterBuildDiff(t *testing.T) {
+	gl := initGptLinter()
+
+	hunk := &diff.Hunk{StartLineNew: 1, LineLengthNew: 100}
+	for i := 1; i <= 100; i++ {
+		hunk.Lines = append(hunk.Lines, &diff.Line{Type: diff.LineAdded, Content: "int foo;", LnumNew: i})
+	}
+
+	ret, added := gl.buildDiff([]*diff.Hunk{hunk}, gptTokens)
+	assert.Equal(t, 100, len(added))
+	assert.Equal(t, false, strings.Contains(ret, gptTruncated))
+
+	ret, added = gl.buildDiff([]*diff.Hunk{hunk}, 20)
+	assert.Equal(t, true, len(added) < 100)
+	assert.Equal(t, true, added[1])
+	assert.Equal(t, true, strings.HasSuffix(ret, gptTruncated))
+	assert.Equal(t, true, len(ret) <= 20*gptRatio+len(gptTruncated))
+}
+
+func TestGptLinterParseReply(t *testing.T) {
+	gl := initGptLinter()
 
-	// TBD: FIXME
+	ret := gl.parseReply("foo.c", "LGTM", map[int]bool{1: true})
+	assert.Equal(t, 0, len(ret))
 
-	assert.Equal(t, nil, nil)
+	ret = gl.parseReply("foo.c", "1: WARN: foo\n2: Error: bar\n - 1: Info: baz", map[int]bool{1: true})
+	assert.Equal(t, 1, len(ret))
+	assert.Equal(t, SeverityWarn, ret[0].Severity)
+	assert.Equal(t, "foo", ret[0].Message)
 }
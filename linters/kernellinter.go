@@ -2,7 +2,6 @@ package linters
 
 import (
 	"context"
-	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
@@ -20,14 +19,18 @@ const (
 	checkPatchSep  = ":"
 )
 
+const (
+	linterKernel = "kernellinter"
+)
+
 var (
-	checkPatchTypes = []string{"Error", "Info", "Warn"}
+	checkPatchTypes = []string{SeverityError, SeverityInfo, SeverityWarn}
 )
 
 type KernelLinter interface {
 	Init(context.Context) error
 	Deinit(context.Context) error
-	Run(context.Context, string, []string) ([]string, error)
+	Run(context.Context, string, []string, []config.LintRule) ([]Finding, error)
 }
 
 type KernelLinterConfig struct {
@@ -66,14 +69,14 @@ func (kl *kernellinter) Deinit(_ context.Context) error {
 	return nil
 }
 
-func (kl *kernellinter) Run(ctx context.Context, path string, files []string) ([]string, error) {
+func (kl *kernellinter) Run(ctx context.Context, path string, files []string, _ []config.LintRule) ([]Finding, error) {
 	kl.cfg.Logger.Debug("kernellinter: Run")
 
 	return kl.lintPatch(ctx, path, files)
 }
 
 // nolint: gosec
-func (kl *kernellinter) lintPatch(ctx context.Context, path string, files []string) ([]string, error) {
+func (kl *kernellinter) lintPatch(ctx context.Context, path string, files []string) ([]Finding, error) {
 	kl.cfg.Logger.Debug("kernellinter: lintPatch")
 
 	parseType := func(name string) string {
@@ -87,24 +90,26 @@ func (kl *kernellinter) lintPatch(ctx context.Context, path string, files []stri
 		return buf
 	}
 
-	buildLint := func(name, out string) []string {
-		var buf []string
+	buildLint := func(name, out string) []Finding {
+		var buf []Finding
 		lines := strings.Split(out, "\n")
 		for _, item := range lines {
-			b := strings.Split(item, checkPatchSep)
+			b := strings.SplitN(item, checkPatchSep, checkPatchLen)
 			if len(b) >= checkPatchLen {
 				num, _ := strconv.Atoi(strings.TrimSpace(b[1]))
-				buf = append(buf, fmt.Sprintf("%s:%d:%s:%s",
-					name,
-					num,
-					parseType(strings.TrimSpace(b[2])),
-					strings.TrimSpace(strings.Join(b[3:], " "))))
+				buf = append(buf, Finding{
+					Linter:   linterKernel,
+					Severity: parseType(strings.TrimSpace(b[2])),
+					File:     name,
+					Line:     num,
+					Message:  strings.TrimSpace(b[3]),
+				})
 			}
 		}
 		return buf
 	}
 
-	var buf []string
+	var buf []Finding
 
 	for _, item := range files {
 		opts := kl.cfg.Options
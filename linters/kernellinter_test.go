@@ -5,8 +5,6 @@ package linters
 import (
 	"context"
 	"path/filepath"
-	"strconv"
-	"strings"
 	"testing"
 
 	"github.com/hashicorp/go-hclog"
@@ -54,17 +52,14 @@ func TestLintPatch(t *testing.T) {
 	assert.Equal(t, nil, err)
 	assert.Equal(t, 3, len(ret))
 
-	buf := strings.Split(ret[0], checkPatchSep)
-	assert.Equal(t, "kernel.c", buf[0])
-	line, _ := strconv.Atoi(buf[1])
-	assert.Equal(t, 1, line)
-	assert.Equal(t, "Warn", buf[2])
-	assert.NotEqual(t, 0, len(buf[3]))
+	assert.Equal(t, "kernel.c", ret[0].File)
+	assert.Equal(t, 1, ret[0].Line)
+	assert.Equal(t, SeverityWarn, ret[0].Severity)
+	assert.Equal(t, linterKernel, ret[0].Linter)
+	assert.NotEqual(t, 0, len(ret[0].Message))
 
-	buf = strings.Split(ret[1], checkPatchSep)
-	assert.Equal(t, "kernel.c", buf[0])
-	line, _ = strconv.Atoi(buf[1])
-	assert.Equal(t, 7, line)
-	assert.Equal(t, "Error", buf[2])
-	assert.NotEqual(t, 0, len(buf[3]))
+	assert.Equal(t, "kernel.c", ret[1].File)
+	assert.Equal(t, 7, ret[1].Line)
+	assert.Equal(t, SeverityError, ret[1].Severity)
+	assert.NotEqual(t, 0, len(ret[1].Message))
 }
@@ -0,0 +1,285 @@
+package linters
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/pkg/errors"
+
+	"github.com/devops-pipeflow/insight-plugin/config"
+)
+
+const (
+	ruleDash  = "dash"
+	ruleHash  = "hash"
+	ruleSlash = "slash"
+)
+
+const (
+	linterLicense = "licenselinter"
+)
+
+const (
+	licenseLines = 30
+	licenseTag   = "SPDX-License-Identifier: "
+)
+
+var (
+	licenseCopyright = regexp.MustCompile(`(?i)\bcopyright\b`)
+	licenseId        = regexp.MustCompile(`^((DocumentRef-[A-Za-z0-9.\-]+:)?LicenseRef-[A-Za-z0-9.\-]+|[A-Za-z0-9][A-Za-z0-9.\-]*\+?)$`)
+	licenseLoose     = regexp.MustCompile(`(?i)spdx[-_ ]*license[-_ ]*ident`)
+	licenseYear      = regexp.MustCompile(`\b(19|20)\d{2}\b`)
+)
+
+type LicenseLinter interface {
+	Init(context.Context) error
+	Deinit(context.Context) error
+	Run(context.Context, string, []string, []config.LintRule) ([]Finding, error)
+}
+
+type LicenseLinterConfig struct {
+	Config config.Config
+	Logger hclog.Logger
+}
+
+type licenselinter struct {
+	cfg   *LicenseLinterConfig
+	rules []config.LintRule
+}
+
+type licenseData struct {
+	License   string
+	Copyright string
+	Year      int
+}
+
+func LicenseLinterNew(_ context.Context, cfg *LicenseLinterConfig) LicenseLinter {
+	return &licenselinter{
+		cfg: cfg,
+	}
+}
+
+func DefaultLicenseLinterConfig() *LicenseLinterConfig {
+	return &LicenseLinterConfig{}
+}
+
+func (ll *licenselinter) Init(_ context.Context) error {
+	ll.cfg.Logger.Debug("licenselinter: Init")
+
+	ll.rules = nil
+
+	ll.register(config.LintRule{
+		Name: ruleSlash,
+		Extensions: []string{
+			".bp", ".c", ".cc", ".cpp", ".cs", ".dart", ".go", ".h", ".hpp", ".java", ".js", ".kt", ".proto", ".rs", ".scala",
+			".swift", ".ts",
+		},
+		Header: "// SPDX-License-Identifier: {{.License}}\n// Copyright (c) {{.Year}} {{.Copyright}}\n",
+	})
+	ll.register(config.LintRule{
+		Name:       ruleHash,
+		Extensions: []string{".bzl", ".cmake", ".gn", ".gni", ".mk", ".pl", ".py", ".rb", ".sh", ".toml", ".yaml", ".yml"},
+		Files:      []string{"BUILD", "CMakeLists.txt", "Dockerfile", "Makefile"},
+		Header:     "# SPDX-License-Identifier: {{.License}}\n# Copyright (c) {{.Year}} {{.Copyright}}\n",
+	})
+	ll.register(config.LintRule{
+		Name:       ruleDash,
+		Extensions: []string{".hs", ".lua", ".sql"},
+		Header:     "-- SPDX-License-Identifier: {{.License}}\n-- Copyright (c) {{.Year}} {{.Copyright}}\n",
+	})
+	ll.register(config.LintRule{
+		Name:       ruleXml,
+		Extensions: []string{".html", ".xml"},
+		Header:     "<!-- SPDX-License-Identifier: {{.License}} -->\n<!-- Copyright (c) {{.Year}} {{.Copyright}} -->\n",
+	})
+
+	return nil
+}
+
+func (ll *licenselinter) Deinit(_ context.Context) error {
+	ll.cfg.Logger.Debug("licenselinter: Deinit")
+
+	return nil
+}
+
+// Run checks the SPDX identifier and the copyright in the first lines of the files by the rules
+// in order, a file is checked by the first rule matched. The header rendered by the rule is
+// suggested as fix if the license of rule is set.
+func (ll *licenselinter) Run(_ context.Context, path string, files []string, rules []config.LintRule) ([]Finding, error) {
+	ll.cfg.Logger.Debug("licenselinter: Run")
+
+	var buf []Finding
+
+	checked := map[string]bool{}
+
+	for _, item := range ll.rules {
+		rule := buildRule(&item, rules)
+		if rule.Disabled {
+			continue
+		}
+		fix, err := ll.buildHeader(&rule)
+		if err != nil {
+			return buf, errors.Wrap(err, "invalid header of "+rule.Name)
+		}
+		for _, file := range matchRule(&rule, files) {
+			if checked[file] {
+				continue
+			}
+			checked[file] = true
+			data, err := os.ReadFile(filepath.Join(path, file))
+			if err != nil {
+				return buf, errors.Wrap(err, "failed to read")
+			}
+			if len(data) == 0 || bytes.IndexByte(data, 0) >= 0 {
+				continue
+			}
+			buf = append(buf, ll.checkHeader(&rule, file, string(data), fix)...)
+		}
+	}
+
+	return buf, nil
+}
+
+func (ll *licenselinter) register(rule config.LintRule) {
+	index := slices.IndexFunc(ll.rules, func(data config.LintRule) bool {
+		return data.Name == rule.Name
+	})
+
+	if index >= 0 {
+		ll.rules[index] = rule
+		return
+	}
+
+	ll.rules = append(ll.rules, rule)
+}
+
+// buildHeader renders the header of rule, nothing is rendered without the license.
+func (ll *licenselinter) buildHeader(rule *config.LintRule) (string, error) {
+	ll.cfg.Logger.Debug("licenselinter: buildHeader")
+
+	t, err := template.New("header").Parse(rule.Header)
+	if err != nil {
+		return "", err
+	}
+
+	if rule.License == "" {
+		return "", nil
+	}
+
+	var buf bytes.Buffer
+
+	if err := t.Execute(&buf, licenseData{License: rule.License, Copyright: rule.Copyright, Year: time.Now().Year()}); err != nil {
+		return "", err
+	}
+
+	lines := strings.Split(buf.String(), newLine)
+	for i := range lines {
+		lines[i] = strings.TrimRight(lines[i], " \t")
+	}
+
+	return strings.Join(lines, newLine), nil
+}
+
+func (ll *licenselinter) checkHeader(rule *config.LintRule, file, data, fix string) []Finding {
+	ll.cfg.Logger.Debug("licenselinter: checkHeader")
+
+	lines := strings.Split(data, newLine)
+	if len(lines) > licenseLines {
+		lines = lines[:licenseLines]
+	}
+
+	finding := func(line int, msg string) Finding {
+		return Finding{
+			Linter:   linterLicense,
+			Rule:     rule.Name,
+			Severity: SeverityError,
+			File:     file,
+			Line:     line,
+			Message:  msg,
+			Fix:      fix,
+		}
+	}
+
+	var buf []Finding
+
+	spdx := slices.IndexFunc(lines, func(data string) bool {
+		return licenseLoose.MatchString(data)
+	})
+
+	if spdx < 0 {
+		buf = append(buf, finding(1, "SPDX-License-Identifier not found"))
+	} else if index := strings.Index(lines[spdx], licenseTag); index < 0 {
+		buf = append(buf, finding(spdx+1, "Malformed SPDX-License-Identifier tag"))
+	} else {
+		expr := lines[spdx][index+len(licenseTag):]
+		expr = strings.Join(strings.Fields(strings.NewReplacer("*/", "", "-->", "").Replace(expr)), " ")
+		if !parseLicense(expr) {
+			buf = append(buf, finding(spdx+1, fmt.Sprintf("Malformed SPDX license expression (found %q)", expr)))
+		} else if rule.License != "" && expr != rule.License {
+			buf = append(buf, finding(spdx+1, fmt.Sprintf("SPDX license mismatch (found %s, expected %s)", expr, rule.License)))
+		}
+	}
+
+	copyright := slices.IndexFunc(lines, func(data string) bool {
+		return licenseCopyright.MatchString(data)
+	})
+
+	switch {
+	case copyright < 0:
+		buf = append(buf, finding(1, "Copyright not found"))
+	case !licenseYear.MatchString(lines[copyright]):
+		buf = append(buf, finding(copyright+1, "Malformed copyright (year not found)"))
+	case rule.Copyright != "" && !strings.Contains(lines[copyright], rule.Copyright):
+		buf = append(buf, finding(copyright+1, "Copyright holder mismatch (expected "+rule.Copyright+")"))
+	}
+
+	return buf
+}
+
+// parseLicense reports whether data is a valid SPDX license expression (e.g., "MIT",
+// "GPL-2.0-only WITH Linux-syscall-note", "(Apache-2.0 OR MIT) AND BSD-3-Clause").
+func parseLicense(data string) bool {
+	tokens := strings.Fields(strings.NewReplacer("(", " ( ", ")", " ) ").Replace(data))
+	if len(tokens) == 0 {
+		return false
+	}
+
+	depth := 0
+	operand := true
+
+	for _, item := range tokens {
+		switch item {
+		case "(":
+			if !operand {
+				return false
+			}
+			depth++
+		case ")":
+			if operand || depth == 0 {
+				return false
+			}
+			depth--
+		case "AND", "OR", "WITH":
+			if operand {
+				return false
+			}
+			operand = true
+		default:
+			if !operand || !licenseId.MatchString(item) {
+				return false
+			}
+			operand = false
+		}
+	}
+
+	return !operand && depth == 0
+}
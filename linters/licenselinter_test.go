@@ -0,0 +1,118 @@
+package linters
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/devops-pipeflow/insight-plugin/config"
+)
+
+func initLicenseLinter() licenselinter {
+	ll := licenselinter{
+		cfg: DefaultLicenseLinterConfig(),
+	}
+
+	ll.cfg.Config = config.Config{}
+	ll.cfg.Logger = hclog.New(&hclog.LoggerOptions{
+		Name:  "licenselinter",
+		Level: hclog.LevelFromString("INFO"),
+	})
+
+	return ll
+}
+
+func TestLicenseLinterRun(t *testing.T) {
+	ctx := context.Background()
+
+	linter := initLicenseLinter()
+	_ = linter.Init(ctx)
+
+	path := t.TempDir()
+	foo := "// SPDX-License-Identifier: MIT\n// Copyright 2024 Foo Inc.\n\npackage foo\n"
+
+	_ = os.WriteFile(filepath.Join(path, "foo.go"), []byte(foo), 0o600)
+	_ = os.WriteFile(filepath.Join(path, "bar.py"), []byte("#!/usr/bin/env python3\n\nprint('bar')\n"), 0o600)
+	_ = os.WriteFile(filepath.Join(path, "baz.xml"), []byte("<!-- SPDX-License-Identifier: MIT -->\n<!-- Copyright 2024 -->\n"), 0o600)
+	_ = os.WriteFile(filepath.Join(path, "qux.txt"), []byte("qux\n"), 0o600)
+
+	files := []string{"foo.go", "bar.py", "baz.xml", "qux.txt"}
+
+	ret, err := linter.Run(ctx, path, files, nil)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, len(ret))
+	assert.Equal(t, Finding{Linter: linterLicense, Rule: ruleHash, Severity: SeverityError, File: "bar.py", Line: 1,
+		Message: "SPDX-License-Identifier not found"}, ret[0])
+	assert.Equal(t, "Copyright not found", ret[1].Message)
+
+	rules := []config.LintRule{
+		{Name: ruleHash, Disabled: true},
+		{Name: ruleSlash, License: "Apache-2.0", Copyright: "Bar Inc."},
+		{Name: ruleXml, Extensions: []string{".html"}},
+	}
+
+	ret, err = linter.Run(ctx, path, files, rules)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, len(ret))
+	assert.Equal(t, "SPDX license mismatch (found MIT, expected Apache-2.0)", ret[0].Message)
+	assert.Equal(t, 1, ret[0].Line)
+	assert.Equal(t, "Copyright holder mismatch (expected Bar Inc.)", ret[1].Message)
+	assert.Equal(t, 2, ret[1].Line)
+
+	header := fmt.Sprintf("// SPDX-License-Identifier: Apache-2.0\n// Copyright (c) %d Bar Inc.\n", time.Now().Year())
+	assert.Equal(t, header, ret[0].Fix)
+
+	_, err = linter.Run(ctx, path, files, []config.LintRule{{Name: ruleSlash, Header: "{{.License"}})
+	assert.NotEqual(t, nil, err)
+
+	_, err = linter.Run(ctx, path, []string{"missing.go"}, nil)
+	assert.NotEqual(t, nil, err)
+}
+
+func TestLicenseLinterCheckHeader(t *testing.T) {
+	linter := initLicenseLinter()
+
+	rule := config.LintRule{Name: ruleSlash}
+
+	check := func(data string) []string {
+		var buf []string
+		for _, item := range linter.checkHeader(&rule, "foo.c", data, "") {
+			buf = append(buf, fmt.Sprintf("%d: %s", item.Line, item.Message))
+		}
+		return buf
+	}
+
+	ret := check("/* SPDX-License-Identifier: GPL-2.0-only WITH Linux-syscall-note */\n/* Copyright (C) 2024 Foo */\n")
+	assert.Equal(t, 0, len(ret))
+
+	ret = check("// SPDX-License-Identifer: MIT\n// Copyright 2024 Foo\n")
+	assert.Equal(t, []string{"1: Malformed SPDX-License-Identifier tag"}, ret)
+
+	ret = check("// Copyright Foo\n// SPDX-License-Identifier: MIT and Apache-2.0\n")
+	assert.Equal(t, []string{
+		`2: Malformed SPDX license expression (found "MIT and Apache-2.0")`,
+		"1: Malformed copyright (year not found)",
+	}, ret)
+
+	ret = check(strings.Repeat("\n", licenseLines) + "// SPDX-License-Identifier: MIT\n// Copyright 2024 Foo\n")
+	assert.Equal(t, []string{"1: SPDX-License-Identifier not found", "1: Copyright not found"}, ret)
+}
+
+func TestParseLicense(t *testing.T) {
+	assert.Equal(t, true, parseLicense("MIT"))
+	assert.Equal(t, true, parseLicense("GPL-2.0+"))
+	assert.Equal(t, true, parseLicense("(Apache-2.0 OR MIT) AND BSD-3-Clause"))
+	assert.Equal(t, true, parseLicense("LicenseRef-Foo"))
+	assert.Equal(t, false, parseLicense(""))
+	assert.Equal(t, false, parseLicense("MIT OR"))
+	assert.Equal(t, false, parseLicense("(MIT"))
+	assert.Equal(t, false, parseLicense("MIT Apache-2.0"))
+	assert.Equal(t, false, parseLicense("MIT/X11"))
+}
@@ -0,0 +1,117 @@
+package linters
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+
+	"github.com/devops-pipeflow/insight-plugin/config"
+)
+
+const (
+	SeverityError = "Error"
+	SeverityInfo  = "Info"
+	SeverityWarn  = "Warn"
+)
+
+// Finding is the lint finding shared by linters, File is relative to the lint path, Line,
+// Column and EndLine start from 1 (0: unknown or the whole file).
+type Finding struct {
+	Linter   string
+	Rule     string
+	Severity string
+	File     string
+	Line     int
+	Column   int
+	EndLine  int
+	Message  string
+	Fix      string
+}
+
+// Tree reads the files of the branch which the change is based on (e.g., by Gitiles), Project is
+// the project of the change and List returns the names in the directory of project (empty: root).
+type Tree interface {
+	Project() string
+	List(ctx context.Context, project, dir string) ([]string, error)
+	Read(ctx context.Context, project, file string) ([]byte, error)
+}
+
+// buildRule returns the rule with the defaults overridden by the configured rules of the same
+// name in order, the files matched are overridden as a whole, the zero limits and the empty
+// strings are ignored and the allowlists are appended.
+func buildRule(defaults *config.LintRule, rules []config.LintRule) config.LintRule {
+	buf := *defaults
+
+	for _, item := range rules {
+		if item.Name != buf.Name {
+			continue
+		}
+		buf.Disabled = item.Disabled
+		if len(item.Extensions) != 0 || len(item.Files) != 0 {
+			buf.Extensions, buf.Files = item.Extensions, item.Files
+		}
+		if item.SubjectMin > 0 {
+			buf.SubjectMin = item.SubjectMin
+		}
+		if item.SubjectMax > 0 {
+			buf.SubjectMax = item.SubjectMax
+		}
+		if item.DescriptionMax > 0 {
+			buf.DescriptionMax = item.DescriptionMax
+		}
+		if item.SubjectStyle != "" {
+			buf.SubjectStyle = item.SubjectStyle
+		}
+		if len(item.Trailers) != 0 {
+			buf.Trailers = item.Trailers
+		}
+		buf.Allowlist.Paths = append(slices.Clone(buf.Allowlist.Paths), item.Allowlist.Paths...)
+		buf.Allowlist.Regexes = append(slices.Clone(buf.Allowlist.Regexes), item.Allowlist.Regexes...)
+		if item.License != "" {
+			buf.License = item.License
+		}
+		if item.Copyright != "" {
+			buf.Copyright = item.Copyright
+		}
+		if item.Header != "" {
+			buf.Header = item.Header
+		}
+		if item.SizeMax > 0 {
+			buf.SizeMax = item.SizeMax
+		}
+		if len(item.Denylist) != 0 {
+			buf.Denylist = item.Denylist
+		}
+		if item.IllegalChars != "" {
+			buf.IllegalChars = item.IllegalChars
+		}
+		if item.Prompt != "" {
+			buf.Prompt = item.Prompt
+		}
+		if item.Tokens > 0 {
+			buf.Tokens = item.Tokens
+		}
+	}
+
+	return buf
+}
+
+// matchRule returns the files matched by the extensions or the file names of rule, all files
+// are matched if neither is set.
+func matchRule(rule *config.LintRule, files []string) []string {
+	if len(rule.Extensions) == 0 && len(rule.Files) == 0 {
+		return files
+	}
+
+	var buf []string
+
+	for _, item := range files {
+		if ext := filepath.Ext(item); ext != "" && slices.Contains(rule.Extensions, ext) {
+			buf = append(buf, item)
+		} else if slices.Contains(rule.Files, filepath.Base(item)) {
+			buf = append(buf, item)
+		}
+	}
+
+	return buf
+}
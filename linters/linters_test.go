@@ -0,0 +1,57 @@
+package linters
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/devops-pipeflow/insight-plugin/config"
+)
+
+func TestBuildRule(t *testing.T) {
+	defaults := config.LintRule{Name: ruleMessage, Files: []string{messageFile}, SubjectMin: 25, SubjectMax: 80}
+
+	ret := buildRule(&defaults, nil)
+	assert.Equal(t, defaults, ret)
+
+	ret = buildRule(&defaults, []config.LintRule{
+		{Name: ruleJson, Disabled: true},
+		{Name: ruleMessage, SubjectMin: 8},
+		{Name: ruleMessage, SubjectMax: 50, DescriptionMax: 100},
+	})
+	assert.Equal(t, false, ret.Disabled)
+	assert.Equal(t, []string{messageFile}, ret.Files)
+	assert.Equal(t, int64(8), ret.SubjectMin)
+	assert.Equal(t, int64(50), ret.SubjectMax)
+	assert.Equal(t, int64(100), ret.DescriptionMax)
+
+	ret = buildRule(&defaults, []config.LintRule{{Name: ruleMessage, Disabled: true, Extensions: []string{".msg"}}})
+	assert.Equal(t, true, ret.Disabled)
+	assert.Equal(t, []string{".msg"}, ret.Extensions)
+	assert.Equal(t, 0, len(ret.Files))
+
+	defaults.Allowlist.Paths = []string{"foo"}
+
+	ret = buildRule(&defaults, []config.LintRule{
+		{Name: ruleMessage, Allowlist: config.LintAllowlist{Paths: []string{"bar"}, Regexes: []string{"qux"}}},
+		{Name: ruleMessage, Allowlist: config.LintAllowlist{Paths: []string{"baz"}}, License: "MIT", Header: "# {{.License}}"},
+	})
+	assert.Equal(t, []string{"foo", "bar", "baz"}, ret.Allowlist.Paths)
+	assert.Equal(t, []string{"qux"}, ret.Allowlist.Regexes)
+	assert.Equal(t, []string{"foo"}, defaults.Allowlist.Paths)
+	assert.Equal(t, "MIT", ret.License)
+	assert.Equal(t, "# {{.License}}", ret.Header)
+}
+
+func TestMatchRule(t *testing.T) {
+	files := []string{"foo.te", "sepolicy/file_contexts", "foo.c", messageFile}
+
+	ret := matchRule(&config.LintRule{}, files)
+	assert.Equal(t, files, ret)
+
+	ret = matchRule(&config.LintRule{Extensions: newlineIncluded, Files: newlineFiles}, files)
+	assert.Equal(t, []string{"foo.te", "sepolicy/file_contexts"}, ret)
+
+	ret = matchRule(&config.LintRule{Files: messageIncluded}, files)
+	assert.Equal(t, []string{messageFile}, ret)
+}
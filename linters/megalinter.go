@@ -3,13 +3,18 @@
 package linters
 
 import (
+	"archive/tar"
 	"bytes"
 	"context"
 	"encoding/base64"
 	"encoding/json"
+	"io"
+	"path"
+	"strings"
 
 	"github.com/docker/docker/api/types/container"
 	"github.com/docker/docker/api/types/image"
+	"github.com/docker/docker/api/types/mount"
 	"github.com/docker/docker/api/types/network"
 	"github.com/docker/docker/client"
 	"github.com/hashicorp/go-hclog"
@@ -19,14 +24,18 @@ import (
 )
 
 const (
-	artifactPath = "oxsecurity"
-	artifactName = "megalinter-cupcake:latest"
+	megaImage     = "oxsecurity/megalinter-cupcake"
+	megaTag       = "latest"
+	megaReport    = "megalinter-report.sarif"
+	megaReports   = "/tmp/megalinter-reports"
+	megaSep       = ","
+	megaWorkspace = "/tmp/lint"
 )
 
 type MegaLinter interface {
 	Init(context.Context) error
 	Deinit(context.Context) error
-	Run(context.Context, string, []string) ([]string, error)
+	Run(context.Context, string, []string, []config.LintRule) ([]Finding, error)
 }
 
 type MegaLinterConfig struct {
@@ -72,38 +81,39 @@ func (ml *megalinter) Init(ctx context.Context) error {
 	return nil
 }
 
-func (ml *megalinter) Deinit(ctx context.Context) error {
+// Deinit keeps the image cached for the next runs.
+func (ml *megalinter) Deinit(_ context.Context) error {
 	ml.cfg.Logger.Debug("megalinter: Deinit")
 
-	defer func(client *client.Client) {
-		if client != nil {
-			_ = client.Close()
-		}
-	}(ml.client)
-
-	if err := ml.removeImage(ctx); err != nil {
-		return errors.Wrap(err, "failed to remove image")
+	if ml.client != nil {
+		_ = ml.client.Close()
 	}
 
 	return nil
 }
 
-func (ml *megalinter) Run(ctx context.Context, path string, files []string) ([]string, error) {
+func (ml *megalinter) Run(ctx context.Context, path string, files []string, _ []config.LintRule) ([]Finding, error) {
 	ml.cfg.Logger.Debug("megalinter: Run")
 
-	report, err := ml.runContainer(ctx)
+	if len(files) == 0 {
+		return nil, nil
+	}
+
+	report, err := ml.runContainer(ctx, path, files)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to run container")
 	}
 
 	buf, err := ml.parseReport(ctx, report)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to run container")
+		return nil, errors.Wrap(err, "failed to parse report")
 	}
 
 	return buf, nil
 }
 
+// pullImage pulls the image by the pull policy, the cached image is used if present unless
+// the policy is always, and is required if never.
 func (ml *megalinter) pullImage(ctx context.Context) error {
 	ml.cfg.Logger.Debug("megalinter: pullImage")
 
@@ -115,6 +125,22 @@ func (ml *megalinter) pullImage(ctx context.Context) error {
 		return buf.String()
 	}
 
+	name := ml.imageName()
+	policy := ml.cfg.Config.Spec.CodeConfig.MegaConfig.PullPolicy
+
+	if policy != config.PullAlways {
+		_, _, err := ml.client.ImageInspectWithRaw(ctx, name)
+		if err == nil {
+			return nil
+		}
+		if !client.IsErrNotFound(err) {
+			return errors.Wrap(err, "failed to inspect image")
+		}
+		if policy == config.PullNever {
+			return errors.New("image not found " + name)
+		}
+	}
+
 	auth := artifactAuth{
 		Username: ml.cfg.Config.Spec.ArtifactConfig.User,
 		Password: ml.cfg.Config.Spec.ArtifactConfig.Pass,
@@ -124,38 +150,62 @@ func (ml *megalinter) pullImage(ctx context.Context) error {
 		RegistryAuth: helper(auth),
 	}
 
-	out, err := ml.client.ImagePull(ctx, artifactPath+"/"+artifactName, options)
+	out, err := ml.client.ImagePull(ctx, name, options)
 	if err != nil {
 		return errors.Wrap(err, "failed to pull image")
 	}
 
-	_ = out.Close()
+	defer func(out io.ReadCloser) {
+		_ = out.Close()
+	}(out)
+
+	// The pull is done once the progress is read to the end.
+	if _, err := io.Copy(io.Discard, out); err != nil {
+		return errors.Wrap(err, "failed to read progress")
+	}
 
 	return nil
 }
 
-func (ml *megalinter) removeImage(ctx context.Context) error {
-	ml.cfg.Logger.Debug("megalinter: removeImage")
+// imageName returns the image reference in registry/image:tag@digest.
+func (ml *megalinter) imageName() string {
+	cfg := ml.cfg.Config.Spec.CodeConfig.MegaConfig
 
-	options := image.RemoveOptions{
-		Force:         true,
-		PruneChildren: true,
+	name := cfg.Image
+	if name == "" {
+		name = megaImage
 	}
 
-	if _, err := ml.client.ImageRemove(ctx, artifactPath+"/"+artifactName, options); err != nil {
-		return errors.Wrap(err, "failed to remove image")
+	if cfg.Registry != "" {
+		name = strings.TrimSuffix(cfg.Registry, "/") + "/" + name
 	}
 
-	return nil
+	tag := cfg.Tag
+	if tag == "" {
+		tag = megaTag
+	}
+
+	// The tag is optional if pinned by digest.
+	if cfg.Tag != "" || cfg.Digest == "" {
+		name += ":" + tag
+	}
+
+	if cfg.Digest != "" {
+		name += "@" + cfg.Digest
+	}
+
+	return name
 }
 
-func (ml *megalinter) runContainer(ctx context.Context) ([]byte, error) {
+// runContainer lints the files in dir mounted as the workspace of MegaLinter, and returns
+// the SARIF report copied out of the container. The container is abandoned and removed if
+// ctx is done before it exits.
+func (ml *megalinter) runContainer(ctx context.Context, dir string, files []string) ([]byte, error) {
 	ml.cfg.Logger.Debug("megalinter: runContainer")
 
-	var buf []byte
+	cfg, hostCfg := ml.buildContainer(dir, files)
 
-	resp, err := ml.client.ContainerCreate(ctx, &container.Config{}, &container.HostConfig{}, &network.NetworkingConfig{},
-		nil, "")
+	resp, err := ml.client.ContainerCreate(ctx, cfg, hostCfg, &network.NetworkingConfig{}, nil, "")
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create container")
 	}
@@ -167,24 +217,113 @@ func (ml *megalinter) runContainer(ctx context.Context) ([]byte, error) {
 			Force:         true,
 		}
 		_ = ml.client.ContainerRemove(ctx, id, opts)
-	}(ctx, ml, resp.ID)
+	}(context.WithoutCancel(ctx), ml, resp.ID)
 
 	if err = ml.client.ContainerStart(ctx, resp.ID, container.StartOptions{}); err != nil {
 		return nil, errors.Wrap(err, "failed to start container")
 	}
 
-	// TBD: FIXME
-	// Load output into buf
+	// MegaLinter exits with non-zero if any error is found, which is reported in the report.
+	statusCh, errCh := ml.client.ContainerWait(ctx, resp.ID, container.WaitConditionNotRunning)
+
+	select {
+	case status := <-statusCh:
+		if status.Error != nil {
+			return nil, errors.New("failed to wait container: " + status.Error.Message)
+		}
+	case err = <-errCh:
+		return nil, errors.Wrap(err, "failed to wait container")
+	}
+
+	out, _, err := ml.client.CopyFromContainer(ctx, resp.ID, path.Join(megaReports, megaReport))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to copy report")
+	}
+
+	defer func(out io.ReadCloser) {
+		_ = out.Close()
+	}(out)
+
+	buf, err := ml.readReport(out)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read report")
+	}
 
 	return buf, nil
 }
 
-func (ml *megalinter) parseReport(_ context.Context, data []byte) ([]string, error) {
+// buildContainer mounts dir as the workspace and lints the files only, the reports are
+// written out of the workspace to keep the fetched change untouched.
+func (ml *megalinter) buildContainer(dir string, files []string) (*container.Config, *container.HostConfig) {
+	ml.cfg.Logger.Debug("megalinter: buildContainer")
+
+	cfg := &container.Config{
+		Image: ml.imageName(),
+		Env: []string{
+			"DEFAULT_WORKSPACE=" + megaWorkspace,
+			"MEGALINTER_FILES_TO_LINT=" + strings.Join(files, megaSep),
+			"REPORT_OUTPUT_FOLDER=" + megaReports,
+			"SARIF_REPORTER=true",
+		},
+	}
+
+	hostCfg := &container.HostConfig{
+		Mounts: []mount.Mount{
+			{
+				Type:     mount.TypeBind,
+				Source:   dir,
+				Target:   megaWorkspace,
+				ReadOnly: true,
+			},
+		},
+	}
+
+	return cfg, hostCfg
+}
+
+// readReport reads the report from the tar archive copied out of the container.
+func (ml *megalinter) readReport(data io.Reader) ([]byte, error) {
+	ml.cfg.Logger.Debug("megalinter: readReport")
+
+	reader := tar.NewReader(data)
+
+	for {
+		header, err := reader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read tar")
+		}
+		if header.Typeflag != tar.TypeReg || path.Base(header.Name) != megaReport {
+			continue
+		}
+		buf, err := io.ReadAll(reader)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read file")
+		}
+		return buf, nil
+	}
+
+	return nil, errors.New("invalid report")
+}
+
+func (ml *megalinter) parseReport(_ context.Context, data []byte) ([]Finding, error) {
 	ml.cfg.Logger.Debug("megalinter: parseReport")
 
-	var buf []string
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	buf, err := ImportSarif(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to import sarif")
+	}
 
-	// TBD: FIXME
+	// The files are reported in the workspace of container, which are relative to path instead.
+	for i := range buf {
+		buf[i].File = strings.TrimPrefix(strings.TrimPrefix(buf[i].File, megaWorkspace), "/")
+	}
 
 	return buf, nil
 }
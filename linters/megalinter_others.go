@@ -0,0 +1,55 @@
+//go:build !linux
+
+package linters
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/pkg/errors"
+
+	"github.com/devops-pipeflow/insight-plugin/config"
+)
+
+type MegaLinter interface {
+	Init(context.Context) error
+	Deinit(context.Context) error
+	Run(context.Context, string, []string, []config.LintRule) ([]Finding, error)
+}
+
+type MegaLinterConfig struct {
+	Config config.Config
+	Logger hclog.Logger
+}
+
+type megalinter struct {
+	cfg *MegaLinterConfig
+}
+
+func MegaLinterNew(_ context.Context, cfg *MegaLinterConfig) MegaLinter {
+	return &megalinter{
+		cfg: cfg,
+	}
+}
+
+func DefaultMegaLinterConfig() *MegaLinterConfig {
+	return &MegaLinterConfig{}
+}
+
+func (ml *megalinter) Init(_ context.Context) error {
+	ml.cfg.Logger.Debug("megalinter: Init")
+
+	return errors.New("megalinter not supported")
+}
+
+func (ml *megalinter) Deinit(_ context.Context) error {
+	ml.cfg.Logger.Debug("megalinter: Deinit")
+
+	return nil
+}
+
+func (ml *megalinter) Run(_ context.Context, _ string, _ []string, _ []config.LintRule) ([]Finding, error) {
+	ml.cfg.Logger.Debug("megalinter: Run")
+
+	return nil, errors.New("megalinter not supported")
+}
@@ -3,8 +3,13 @@
 package linters
 
 import (
+	"archive/tar"
+	"bytes"
+	"context"
+	"slices"
 	"testing"
 
+	"github.com/docker/docker/api/types/mount"
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
 
@@ -26,33 +31,92 @@ func initMegaLinter() megalinter {
 }
 
 func TestPullImage(t *testing.T) {
-	_ = initMegaLinter()
+	ml := initMegaLinter()
 
-	// TBD: FIXME
+	assert.Equal(t, megaImage+":"+megaTag, ml.imageName())
 
-	assert.Equal(t, nil, nil)
-}
-
-func TestRemoveImage(t *testing.T) {
-	_ = initMegaLinter()
+	ml.cfg.Config.Spec.CodeConfig.MegaConfig = config.MegaConfig{
+		Registry: "127.0.0.1:5000/",
+		Image:    "oxsecurity/megalinter-go",
+		Tag:      "v7",
+	}
+	assert.Equal(t, "127.0.0.1:5000/oxsecurity/megalinter-go:v7", ml.imageName())
 
-	// TBD: FIXME
+	ml.cfg.Config.Spec.CodeConfig.MegaConfig = config.MegaConfig{Digest: "sha256:0123456789"}
+	assert.Equal(t, megaImage+"@sha256:0123456789", ml.imageName())
 
-	assert.Equal(t, nil, nil)
+	ml.cfg.Config.Spec.CodeConfig.MegaConfig.Tag = "v7"
+	assert.Equal(t, megaImage+":v7@sha256:0123456789", ml.imageName())
 }
 
 func TestRunContainer(t *testing.T) {
-	_ = initMegaLinter()
-
-	// TBD: FIXME
-
-	assert.Equal(t, nil, nil)
+	ml := initMegaLinter()
+
+	cfg, hostCfg := ml.buildContainer("/path/to/change", []string{"foo.sh", "bar/bar.py"})
+	assert.Equal(t, megaImage+":"+megaTag, cfg.Image)
+	assert.Equal(t, true, slices.Contains(cfg.Env, "MEGALINTER_FILES_TO_LINT=foo.sh,bar/bar.py"))
+	assert.Equal(t, true, slices.Contains(cfg.Env, "SARIF_REPORTER=true"))
+	assert.Equal(t, 1, len(hostCfg.Mounts))
+	assert.Equal(t, mount.TypeBind, hostCfg.Mounts[0].Type)
+	assert.Equal(t, "/path/to/change", hostCfg.Mounts[0].Source)
+	assert.Equal(t, megaWorkspace, hostCfg.Mounts[0].Target)
+
+	var data bytes.Buffer
+
+	writer := tar.NewWriter(&data)
+	_ = writer.WriteHeader(&tar.Header{Name: "megalinter-reports/", Typeflag: tar.TypeDir, Mode: 0o755})
+	_ = writer.WriteHeader(&tar.Header{Name: "megalinter-reports/" + megaReport, Typeflag: tar.TypeReg, Mode: 0o644, Size: 2})
+	_, _ = writer.Write([]byte("{}"))
+	_ = writer.Close()
+
+	buf, err := ml.readReport(&data)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "{}", string(buf))
+
+	_, err = ml.readReport(&bytes.Buffer{})
+	assert.NotEqual(t, nil, err)
 }
 
 func TestParseReport(t *testing.T) {
-	_ = initMegaLinter()
-
-	// TBD: FIXME
-
-	assert.Equal(t, nil, nil)
+	ctx := context.Background()
+	ml := initMegaLinter()
+
+	buf, err := ml.parseReport(ctx, nil)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(buf))
+
+	_, err = ml.parseReport(ctx, []byte("invalid"))
+	assert.NotEqual(t, nil, err)
+
+	data := `{
+  "version": "2.1.0",
+  "runs": [
+    {
+      "tool": {"driver": {"name": "shellcheck"}},
+      "results": [
+        {
+          "ruleId": "SC2086",
+          "level": "error",
+          "message": {"text": "Double quote to prevent globbing"},
+          "locations": [
+            {
+              "physicalLocation": {
+                "artifactLocation": {"uri": "/tmp/lint/script/foo.sh"},
+                "region": {"startLine": 3}
+              }
+            }
+          ]
+        }
+      ]
+    }
+  ]
+}`
+
+	buf, err = ml.parseReport(ctx, []byte(data))
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(buf))
+	assert.Equal(t, "shellcheck", buf[0].Linter)
+	assert.Equal(t, SeverityError, buf[0].Severity)
+	assert.Equal(t, "script/foo.sh", buf[0].File)
+	assert.Equal(t, 3, buf[0].Line)
 }
@@ -0,0 +1,236 @@
+package linters
+
+import (
+	"encoding/json"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	sarifSchema  = "https://json.schemastore.org/sarif-2.1.0.json"
+	sarifVersion = "2.1.0"
+
+	sarifError   = "error"
+	sarifFile    = "file://"
+	sarifFix     = "fix"
+	sarifNone    = "none"
+	sarifNote    = "note"
+	sarifUnknown = "unknown"
+	sarifWarning = "warning"
+)
+
+// SARIF 2.1.0 (subset), see https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+type sarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema,omitempty"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name  string      `json:"name"`
+	Rules []sarifRule `json:"rules,omitempty"`
+}
+
+type sarifRule struct {
+	ID string `json:"id"`
+}
+
+type sarifResult struct {
+	RuleID     string                 `json:"ruleId,omitempty"`
+	Level      string                 `json:"level,omitempty"`
+	Message    sarifMessage           `json:"message"`
+	Locations  []sarifLocation        `json:"locations,omitempty"`
+	Fixes      []sarifFixItem         `json:"fixes,omitempty"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+type sarifMessage struct {
+	Text     string `json:"text,omitempty"`
+	Markdown string `json:"markdown,omitempty"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysical `json:"physicalLocation"`
+}
+
+type sarifPhysical struct {
+	ArtifactLocation sarifArtifact `json:"artifactLocation"`
+	Region           *sarifRegion  `json:"region,omitempty"`
+}
+
+type sarifArtifact struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine,omitempty"`
+	StartColumn int `json:"startColumn,omitempty"`
+	EndLine     int `json:"endLine,omitempty"`
+}
+
+type sarifFixItem struct {
+	Description sarifMessage `json:"description"`
+}
+
+// ExportSarif returns the findings in a SARIF 2.1.0 log, one run per linter with its rules and results.
+func ExportSarif(findings []Finding) ([]byte, error) {
+	runs := map[string]*sarifRun{}
+
+	for _, item := range findings {
+		name := item.Linter
+		if name == "" {
+			name = sarifUnknown
+		}
+		run, ok := runs[name]
+		if !ok {
+			run = &sarifRun{Tool: sarifTool{Driver: sarifDriver{Name: name}}, Results: []sarifResult{}}
+			runs[name] = run
+		}
+		if item.Rule != "" && !slices.ContainsFunc(run.Tool.Driver.Rules, func(data sarifRule) bool {
+			return data.ID == item.Rule
+		}) {
+			run.Tool.Driver.Rules = append(run.Tool.Driver.Rules, sarifRule{ID: item.Rule})
+		}
+		run.Results = append(run.Results, exportResult(&item))
+	}
+
+	names := make([]string, 0, len(runs))
+	for key := range runs {
+		names = append(names, key)
+	}
+
+	sort.Strings(names)
+
+	log := sarifLog{
+		Version: sarifVersion,
+		Schema:  sarifSchema,
+		Runs:    []sarifRun{},
+	}
+
+	for _, item := range names {
+		run := runs[item]
+		sort.Slice(run.Tool.Driver.Rules, func(i, j int) bool {
+			return run.Tool.Driver.Rules[i].ID < run.Tool.Driver.Rules[j].ID
+		})
+		log.Runs = append(log.Runs, *run)
+	}
+
+	buf, err := json.MarshalIndent(log, "", "  ")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal")
+	}
+
+	return buf, nil
+}
+
+// ImportSarif returns the findings in a SARIF 2.1.0 log produced by external tools (e.g., MegaLinter),
+// the linter is named after the tool driver.
+func ImportSarif(data []byte) ([]Finding, error) {
+	var log sarifLog
+
+	if err := json.Unmarshal(data, &log); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal")
+	}
+
+	if log.Version != sarifVersion {
+		return nil, errors.New("invalid version " + log.Version)
+	}
+
+	var buf []Finding
+
+	for _, run := range log.Runs {
+		for i := range run.Results {
+			buf = append(buf, importResult(run.Tool.Driver.Name, &run.Results[i]))
+		}
+	}
+
+	return buf, nil
+}
+
+func exportResult(data *Finding) sarifResult {
+	levels := map[string]string{
+		SeverityError: sarifError,
+		SeverityInfo:  sarifNote,
+		SeverityWarn:  sarifWarning,
+	}
+
+	ret := sarifResult{
+		RuleID:  data.Rule,
+		Level:   levels[data.Severity],
+		Message: sarifMessage{Text: data.Message},
+	}
+
+	if ret.Level == "" {
+		ret.Level = sarifNone
+	}
+
+	if data.File != "" {
+		loc := sarifLocation{PhysicalLocation: sarifPhysical{ArtifactLocation: sarifArtifact{URI: data.File}}}
+		if data.Line > 0 {
+			loc.PhysicalLocation.Region = &sarifRegion{StartLine: data.Line, StartColumn: data.Column, EndLine: data.EndLine}
+		}
+		ret.Locations = []sarifLocation{loc}
+	}
+
+	// A SARIF fix requires the artifact changes, the suggested fix in text is kept in properties.
+	if data.Fix != "" {
+		ret.Properties = map[string]interface{}{sarifFix: data.Fix}
+	}
+
+	return ret
+}
+
+func importResult(name string, data *sarifResult) Finding {
+	levels := map[string]string{
+		sarifError:   SeverityError,
+		sarifNone:    SeverityInfo,
+		sarifNote:    SeverityInfo,
+		sarifWarning: SeverityWarn,
+	}
+
+	ret := Finding{
+		Linter:   name,
+		Rule:     data.RuleID,
+		Severity: levels[data.Level],
+		Message:  data.Message.Text,
+	}
+
+	// The level defaults to warning in SARIF.
+	if ret.Severity == "" {
+		ret.Severity = SeverityWarn
+	}
+
+	if ret.Message == "" {
+		ret.Message = data.Message.Markdown
+	}
+
+	if len(data.Locations) != 0 {
+		loc := data.Locations[0].PhysicalLocation
+		ret.File = strings.TrimPrefix(loc.ArtifactLocation.URI, sarifFile)
+		if loc.Region != nil {
+			ret.Line = loc.Region.StartLine
+			ret.Column = loc.Region.StartColumn
+			ret.EndLine = loc.Region.EndLine
+		}
+	}
+
+	if fix, ok := data.Properties[sarifFix].(string); ok {
+		ret.Fix = fix
+	} else if len(data.Fixes) != 0 {
+		ret.Fix = data.Fixes[0].Description.Text
+	}
+
+	return ret
+}
@@ -0,0 +1,104 @@
+package linters
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExportSarif(t *testing.T) {
+	buf, err := ExportSarif(nil)
+	assert.Equal(t, nil, err)
+
+	var log sarifLog
+
+	err = json.Unmarshal(buf, &log)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, sarifVersion, log.Version)
+	assert.Equal(t, 0, len(log.Runs))
+
+	findings := []Finding{
+		{Linter: "kernellinter", Rule: "SPACING", Severity: SeverityWarn, File: "foo.c", Line: 2, Column: 3, Message: "foo"},
+		{Linter: "commitlinter", Rule: "xml", Severity: SeverityError, File: "foo.xml", Message: "bar"},
+		{Linter: "kernellinter", Rule: "SPACING", Severity: SeverityError, File: "foo.c", Line: 5, Message: "baz", Fix: "int foo;"},
+		{Linter: "kernellinter", Rule: "BRACES", Severity: SeverityInfo, File: "foo:bar.c", Line: 7, Message: "a: b"},
+	}
+
+	buf, err = ExportSarif(findings)
+	assert.Equal(t, nil, err)
+
+	err = json.Unmarshal(buf, &log)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, len(log.Runs))
+	assert.Equal(t, "commitlinter", log.Runs[0].Tool.Driver.Name)
+	assert.Equal(t, "kernellinter", log.Runs[1].Tool.Driver.Name)
+	assert.Equal(t, []sarifRule{{ID: "BRACES"}, {ID: "SPACING"}}, log.Runs[1].Tool.Driver.Rules)
+	assert.Equal(t, 3, len(log.Runs[1].Results))
+	assert.Equal(t, sarifWarning, log.Runs[1].Results[0].Level)
+	assert.Equal(t, 3, log.Runs[1].Results[0].Locations[0].PhysicalLocation.Region.StartColumn)
+	assert.Equal(t, (*sarifRegion)(nil), log.Runs[0].Results[0].Locations[0].PhysicalLocation.Region)
+
+	ret, err := ImportSarif(buf)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 4, len(ret))
+	assert.Equal(t, findings[1], ret[0])
+	assert.Equal(t, findings[0], ret[1])
+	assert.Equal(t, findings[2], ret[2])
+	assert.Equal(t, findings[3], ret[3])
+}
+
+func TestImportSarif(t *testing.T) {
+	_, err := ImportSarif([]byte("invalid"))
+	assert.NotEqual(t, nil, err)
+
+	_, err = ImportSarif([]byte(`{"version": "1.0.0", "runs": []}`))
+	assert.NotEqual(t, nil, err)
+
+	data := `{
+  "version": "2.1.0",
+  "runs": [
+    {
+      "tool": {"driver": {"name": "ShellCheck"}},
+      "results": [
+        {
+          "ruleId": "SC2086",
+          "message": {"text": "Double quote to prevent globbing"},
+          "locations": [
+            {
+              "physicalLocation": {
+                "artifactLocation": {"uri": "file://script/build.sh"},
+                "region": {"startLine": 3, "startColumn": 5, "endLine": 3}
+              }
+            }
+          ],
+          "fixes": [{"description": {"text": "Quote it"}, "artifactChanges": []}],
+          "properties": {"tags": ["shell"]}
+        },
+        {
+          "level": "note",
+          "message": {"markdown": "**foo**"}
+        }
+      ]
+    }
+  ]
+}`
+
+	ret, err := ImportSarif([]byte(data))
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, len(ret))
+	assert.Equal(t, Finding{
+		Linter:   "ShellCheck",
+		Rule:     "SC2086",
+		Severity: SeverityWarn,
+		File:     "script/build.sh",
+		Line:     3,
+		Column:   5,
+		EndLine:  3,
+		Message:  "Double quote to prevent globbing",
+		Fix:      "Quote it",
+	}, ret[0])
+	assert.Equal(t, SeverityInfo, ret[1].Severity)
+	assert.Equal(t, "**foo**", ret[1].Message)
+	assert.Equal(t, "", ret[1].File)
+}
@@ -0,0 +1,324 @@
+package linters
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/pkg/errors"
+
+	"github.com/devops-pipeflow/insight-plugin/config"
+)
+
+const (
+	ruleAwsKey     = "aws-access-key"
+	ruleAwsSecret  = "aws-secret-key"
+	ruleEntropy    = "high-entropy"
+	ruleGcpKey     = "gcp-api-key"
+	ruleGcpAccount = "gcp-service-account"
+	ruleJwt        = "jwt"
+	rulePassword   = "password"
+	rulePrivateKey = "private-key"
+	ruleUrlCred    = "url-credential"
+)
+
+const (
+	linterSecret = "secretlinter"
+)
+
+const (
+	secretAllow   = "insight:allow"
+	secretEntropy = 4.2
+	secretLen     = 24
+)
+
+var (
+	secretConfigs = []string{
+		".cfg", ".conf", ".env", ".gradle", ".ini", ".json", ".properties", ".toml", ".xml", ".yaml", ".yml",
+	}
+	secretFiles = []string{".env", ".netrc", ".npmrc", ".pypirc", "credentials", "manifest.xml"}
+
+	// Placeholders (e.g., ${PASS}, {{.Pass}}, %PASS%, <pass>) are not secrets.
+	secretPlaceholder = regexp.MustCompile(`^(\$|\{\{|%|<|\*+$)`)
+
+	// Booleans, numbers and paths (e.g., bypass: true, pwd: /home/build) are not passwords.
+	secretTrivial = regexp.MustCompile(`(?i)^(true|false|yes|no|on|off|null|none|[0-9.]+|[./~].*|[a-z]:\\.*)$`)
+)
+
+type SecretLinter interface {
+	Init(context.Context) error
+	Deinit(context.Context) error
+	Run(context.Context, string, []string, []config.LintRule) ([]Finding, error)
+}
+
+type SecretLinterConfig struct {
+	Config config.Config
+	Logger hclog.Logger
+}
+
+// secretRule is a registered rule, the secret is the first group of pattern if any, or the
+// whole match otherwise. The trivial values are skipped for the rules of config values.
+type secretRule struct {
+	rule    config.LintRule
+	pattern *regexp.Regexp
+	message string
+	entropy bool
+	value   bool
+}
+
+type secretlinter struct {
+	cfg   *SecretLinterConfig
+	rules []secretRule
+}
+
+func SecretLinterNew(_ context.Context, cfg *SecretLinterConfig) SecretLinter {
+	return &secretlinter{
+		cfg: cfg,
+	}
+}
+
+func DefaultSecretLinterConfig() *SecretLinterConfig {
+	return &SecretLinterConfig{}
+}
+
+func (sl *secretlinter) Init(_ context.Context) error {
+	sl.cfg.Logger.Debug("secretlinter: Init")
+
+	sl.rules = nil
+
+	sl.register(secretRule{
+		rule:    config.LintRule{Name: rulePrivateKey},
+		pattern: regexp.MustCompile(`-----BEGIN ((EC|DSA|RSA|OPENSSH|PGP|ENCRYPTED) )?PRIVATE KEY( BLOCK)?-----`),
+		message: "Private key found",
+	})
+	sl.register(secretRule{
+		rule:    config.LintRule{Name: ruleAwsKey},
+		pattern: regexp.MustCompile(`\b((AKIA|ASIA|AGPA|AIDA|AROA|ANPA|ANVA|AIPA)[0-9A-Z]{16})\b`),
+		message: "AWS access key found",
+	})
+	sl.register(secretRule{
+		rule:    config.LintRule{Name: ruleAwsSecret},
+		pattern: regexp.MustCompile(`(?i)aws_?secret_?(access_?)?key["']?\s*[:=]\s*["']?([0-9a-zA-Z/+]{40})\b`),
+		message: "AWS secret key found",
+	})
+	sl.register(secretRule{
+		rule:    config.LintRule{Name: ruleGcpKey},
+		pattern: regexp.MustCompile(`\b(AIza[0-9A-Za-z_\-]{35})`),
+		message: "GCP API key found",
+	})
+	sl.register(secretRule{
+		rule:    config.LintRule{Name: ruleGcpAccount, Extensions: []string{".json"}},
+		pattern: regexp.MustCompile(`"private_key_id"\s*:\s*"([0-9a-f]{40})"`),
+		message: "GCP service account key found",
+	})
+	sl.register(secretRule{
+		rule:    config.LintRule{Name: ruleJwt},
+		pattern: regexp.MustCompile(`\b(eyJ[A-Za-z0-9_-]{10,}\.eyJ[A-Za-z0-9_-]{10,}\.[A-Za-z0-9_-]{10,})`),
+		message: "JSON web token found",
+	})
+	sl.register(secretRule{
+		rule:    config.LintRule{Name: ruleUrlCred},
+		pattern: regexp.MustCompile(`[a-zA-Z][a-zA-Z0-9+.-]*://[^/\s:@"'<>]+:([^/\s:@"'<>]+)@`),
+		message: "Credential in URL found",
+	})
+	sl.register(secretRule{
+		rule: config.LintRule{Name: rulePassword, Extensions: secretConfigs, Files: secretFiles},
+		// The key is bounded by a non-alphanumeric or camel case (e.g., db_password, dbPassword), so
+		// that bypass and compass are not matched.
+		pattern: regexp.MustCompile(`(?:(?:^|[^A-Za-z0-9])(?i:password|passwd|pwd|pass|secret|token|api_?key)|` +
+			`[a-z0-9](?:Password|Passwd|Pwd|Pass|Secret|Token|ApiKey|Api_?Key))["']?\s*[:=]\s*["']?([^\s"'<>,;]{4,})`),
+		message: "Password in config found",
+		value:   true,
+	})
+	sl.register(secretRule{
+		rule:    config.LintRule{Name: ruleEntropy, Extensions: secretConfigs, Files: secretFiles},
+		pattern: regexp.MustCompile(`[A-Za-z0-9+/=_\-]{24,}`),
+		message: "High entropy string found",
+		entropy: true,
+	})
+
+	return nil
+}
+
+func (sl *secretlinter) Deinit(_ context.Context) error {
+	sl.cfg.Logger.Debug("secretlinter: Deinit")
+
+	return nil
+}
+
+// Run scans the files by the rules in the order registered, the lines with the allow comment
+// (insight:allow), the files matched by the allowed paths and the secrets matched by the allowed
+// regexes of rule are skipped. The secrets are not quoted in the findings which are posted to review.
+func (sl *secretlinter) Run(_ context.Context, path string, files []string, rules []config.LintRule) ([]Finding, error) {
+	sl.cfg.Logger.Debug("secretlinter: Run")
+
+	contents := map[string][]string{}
+
+	load := func(name string) ([]string, error) {
+		if lines, ok := contents[name]; ok {
+			return lines, nil
+		}
+		data, err := os.ReadFile(filepath.Join(path, name))
+		if err != nil {
+			return nil, err
+		}
+		var lines []string
+		if bytes.IndexByte(data, 0) < 0 {
+			lines = strings.Split(string(data), newLine)
+		}
+		contents[name] = lines
+		return lines, nil
+	}
+
+	var buf []Finding
+
+	// A secret matched by several rules (e.g., password and high-entropy) is reported once, the
+	// byte spans of the secrets reported are kept per line.
+	seen := map[string][][2]int{}
+
+	for _, item := range sl.rules {
+		rule := buildRule(&item.rule, rules)
+		if rule.Disabled {
+			continue
+		}
+		paths, err := buildAllows(rule.Allowlist.Paths)
+		if err != nil {
+			return buf, errors.Wrap(err, "invalid allowlist of "+rule.Name)
+		}
+		regexes, err := buildAllows(rule.Allowlist.Regexes)
+		if err != nil {
+			return buf, errors.Wrap(err, "invalid allowlist of "+rule.Name)
+		}
+		for _, file := range matchRule(&rule, files) {
+			if matchAllows(paths, file) {
+				continue
+			}
+			lines, err := load(file)
+			if err != nil {
+				return buf, errors.Wrap(err, "failed to read")
+			}
+			buf = append(buf, sl.scanLines(&item, &rule, file, lines, regexes, seen)...)
+		}
+	}
+
+	return buf, nil
+}
+
+func (sl *secretlinter) register(rule secretRule) {
+	index := slices.IndexFunc(sl.rules, func(data secretRule) bool {
+		return data.rule.Name == rule.rule.Name
+	})
+
+	if index >= 0 {
+		sl.rules[index] = rule
+		return
+	}
+
+	sl.rules = append(sl.rules, rule)
+}
+
+// scanLines returns the secrets matched by the rule in lines, the secrets overlapped the spans
+// seen on the same line are skipped.
+func (sl *secretlinter) scanLines(item *secretRule, rule *config.LintRule, file string, lines []string,
+	allows []*regexp.Regexp, seen map[string][][2]int) []Finding {
+	var buf []Finding
+
+	overlap := func(key string, start, end int) bool {
+		return slices.ContainsFunc(seen[key], func(span [2]int) bool {
+			return start < span[1] && span[0] < end
+		})
+	}
+
+	for index, line := range lines {
+		if strings.Contains(line, secretAllow) {
+			continue
+		}
+		for _, match := range item.pattern.FindAllStringSubmatchIndex(line, -1) {
+			start, end := match[0], match[1]
+			// The last group matched is the secret.
+			for i := len(match)/2 - 1; i > 0; i-- {
+				if match[2*i] >= 0 {
+					start, end = match[2*i], match[2*i+1]
+					break
+				}
+			}
+			secret := line[start:end]
+			if secretPlaceholder.MatchString(secret) || matchAllows(allows, secret) {
+				continue
+			}
+			if item.value && secretTrivial.MatchString(secret) {
+				continue
+			}
+			if item.entropy && !isRandom(secret) {
+				continue
+			}
+			key := fmt.Sprintf("%s:%d", file, index+1)
+			if overlap(key, start, end) {
+				continue
+			}
+			seen[key] = append(seen[key], [2]int{start, end})
+			buf = append(buf, Finding{
+				Linter:   linterSecret,
+				Rule:     rule.Name,
+				Severity: SeverityError,
+				File:     file,
+				Line:     index + 1,
+				Column:   utf8.RuneCountInString(line[:start]) + 1,
+				Message:  item.message,
+			})
+		}
+	}
+
+	return buf
+}
+
+func buildAllows(data []string) ([]*regexp.Regexp, error) {
+	var buf []*regexp.Regexp
+
+	for _, item := range data {
+		r, err := regexp.Compile(item)
+		if err != nil {
+			return nil, err
+		}
+		buf = append(buf, r)
+	}
+
+	return buf, nil
+}
+
+func matchAllows(allows []*regexp.Regexp, data string) bool {
+	return slices.ContainsFunc(allows, func(r *regexp.Regexp) bool {
+		return r.MatchString(data)
+	})
+}
+
+// isRandom reports whether data looks random (e.g., a token), which has letters and digits
+// with high Shannon entropy.
+func isRandom(data string) bool {
+	if len(data) < secretLen || !strings.ContainsFunc(data, unicode.IsDigit) || !strings.ContainsFunc(data, unicode.IsLetter) {
+		return false
+	}
+
+	counts := map[rune]int{}
+	for _, item := range data {
+		counts[item]++
+	}
+
+	var entropy float64
+
+	for _, item := range counts {
+		p := float64(item) / float64(len(data))
+		entropy -= p * math.Log2(p)
+	}
+
+	return entropy >= secretEntropy
+}
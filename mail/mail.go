@@ -0,0 +1,363 @@
+package mail
+
+import (
+	"bytes"
+	"context"
+	htmltemplate "html/template"
+	netmail "net/mail"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/pkg/errors"
+
+	"github.com/devops-pipeflow/insight-plugin/config"
+	"github.com/devops-pipeflow/insight-plugin/proto"
+)
+
+const (
+	ContentTypeHtml  = "text/html"
+	ContentTypePlain = "text/plain"
+)
+
+const (
+	fromAddress = "pipeflow"
+	tempExpiry  = 24 * time.Hour
+	tempPattern = "insight-mail-"
+)
+
+const (
+	htmlPrefix = "<html><body><pre>"
+	htmlSuffix = "</pre></body></html>"
+)
+
+const (
+	subjectDefault = `[{{.Sight}}]: {{.Title}}`
+
+	bodyDefault = `{{.Title}}
+{{- if .Data}}
+
+{{printf "%+v" .Data}}
+{{- end}}
+`
+
+	bodyBuild = `{{.Title}}
+{{- with .Data}}
+{{- if .RootCause}}
+
+Root cause:
+{{.RootCause}}
+{{- end}}
+{{- if .Suggestion}}
+
+Suggested fix:
+{{.Suggestion}}
+{{- end}}
+{{- if .LoggingInfos}}
+
+Errors:
+{{- range .LoggingInfos}}
+{{- if eq .Type "error"}}
+{{if .File}}{{.File}}:{{.StartLine}}{{else}}logging:{{.StartLine}}-{{.EndLine}}{{end}}
+{{.Detail}}
+{{- end}}
+{{- end}}
+{{- end}}
+{{- if .RepoInfos}}
+
+Suspect commits:
+{{- range .RepoInfos}}
+{{.Commit}} {{.Author}} {{.Date}}
+{{- end}}
+{{- end}}
+{{- if .ReviewInfos}}
+
+Reviews:
+{{- range .ReviewInfos}}
+{{.Change}} {{.Project}} {{.Branch}} {{.Owner}}
+{{- end}}
+{{- end}}
+{{- if .Error}}
+
+Error:
+{{.Error}}
+{{- end}}
+{{- end}}
+`
+
+	bodyCode = `{{.Title}}
+{{- with .Data}}
+{{- if .Findings}}
+
+Findings:
+{{- range .Findings}}
+{{.File}}{{if .Line}}:{{.Line}}{{end}} [{{.Linter}}{{if .Rule}}/{{.Rule}}{{end}}] {{.Severity}}: {{.Message}}
+{{- end}}
+{{- end}}
+{{- if .Error}}
+
+Error:
+{{.Error}}
+{{- end}}
+{{- end}}
+`
+
+	bodyNode = `{{.Title}}
+{{- with .Data}}
+
+Host: {{.NodeStat.HostStat.Hostname}} ({{.NodeStat.HostStat.Platform}} {{.NodeStat.HostStat.PlatformVersion}})
+{{- if .Error}}
+
+Error:
+{{.Error}}
+{{- end}}
+{{- end}}
+`
+)
+
+var (
+	bodyTemplates = map[string]string{
+		"buildsight": bodyBuild,
+		"codesight":  bodyCode,
+		"nodesight":  bodyNode,
+	}
+)
+
+type Mail interface {
+	Init(context.Context) error
+	Deinit(context.Context) error
+	Run(context.Context, *Content) (proto.MailInfo, error)
+}
+
+type Config struct {
+	Config config.Config
+	Logger hclog.Logger
+}
+
+// Content is the mail content of a sight, Data is the sight info (e.g., proto.BuildInfo)
+// used in the templates, Attachments are written into files and attached by name.
+//
+// The attachment files written by Run are owned by the caller (e.g., pipeflow), which removes
+// them once mailed. They are kept after Deinit, and the ones left over tempExpiry are removed
+// by the next Run of the same sight.
+type Content struct {
+	Sight       string
+	Title       string
+	Data        any
+	ToAddresses []string
+	CcAddresses []string
+	Attachments map[string][]byte
+}
+
+type mail struct {
+	cfg *Config
+}
+
+func New(_ context.Context, cfg *Config) Mail {
+	return &mail{
+		cfg: cfg,
+	}
+}
+
+func DefaultConfig() *Config {
+	return &Config{}
+}
+
+func (m *mail) Init(_ context.Context) error {
+	m.cfg.Logger.Debug("mail: Init")
+
+	return nil
+}
+
+func (m *mail) Deinit(_ context.Context) error {
+	m.cfg.Logger.Debug("mail: Deinit")
+
+	return nil
+}
+
+func (m *mail) Run(_ context.Context, content *Content) (proto.MailInfo, error) {
+	m.cfg.Logger.Debug("mail: Run")
+
+	var mailInfo proto.MailInfo
+
+	cfg := m.cfg.Config.Spec.MailConfig
+
+	mailInfo.ContentType = ContentTypePlain
+	if cfg.ContentType == ContentTypeHtml {
+		mailInfo.ContentType = ContentTypeHtml
+	}
+
+	mailInfo.FromAddress = cfg.FromAddress
+	if mailInfo.FromAddress == "" {
+		mailInfo.FromAddress = fromAddress
+	}
+
+	mailInfo.ToAddresses = Addresses(content.ToAddresses...)
+	mailInfo.CcAddresses = slices.DeleteFunc(Addresses(content.CcAddresses...), func(data string) bool {
+		return slices.Contains(mailInfo.ToAddresses, data)
+	})
+
+	subject, body := subjectDefault, bodyTemplates[content.Sight]
+	if body == "" {
+		body = bodyDefault
+	}
+
+	html := false
+
+	for _, item := range cfg.Templates {
+		if item.Name != content.Sight {
+			continue
+		}
+		if item.Subject != "" {
+			subject = item.Subject
+		}
+		if item.Body != "" {
+			body = item.Body
+			html = mailInfo.ContentType == ContentTypeHtml
+		}
+	}
+
+	var err error
+
+	mailInfo.Subject, err = renderText(subject, content)
+	if err != nil {
+		return mailInfo, errors.Wrap(err, "failed to render subject")
+	}
+
+	mailInfo.Subject = strings.Join(strings.Fields(mailInfo.Subject), " ")
+
+	if html {
+		mailInfo.Body, err = renderHtml(body, content)
+	} else {
+		mailInfo.Body, err = renderText(body, content)
+		if err == nil && mailInfo.ContentType == ContentTypeHtml {
+			mailInfo.Body = HtmlBody(mailInfo.Body)
+		}
+	}
+
+	if err != nil {
+		return mailInfo, errors.Wrap(err, "failed to render body")
+	}
+
+	mailInfo.Attachments, err = m.writeAttachments(content)
+	if err != nil {
+		return mailInfo, errors.Wrap(err, "failed to write attachments")
+	}
+
+	return mailInfo, nil
+}
+
+// writeAttachments writes the attachments into a temp directory per run, which is removed
+// if failed to write.
+func (m *mail) writeAttachments(content *Content) ([]string, error) {
+	m.cfg.Logger.Debug("mail: writeAttachments")
+
+	m.cleanAttachments(content.Sight)
+
+	if len(content.Attachments) == 0 {
+		return nil, nil
+	}
+
+	dir, err := os.MkdirTemp("", tempPattern+content.Sight+"-")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to make temp")
+	}
+
+	names := make([]string, 0, len(content.Attachments))
+	for key := range content.Attachments {
+		names = append(names, key)
+	}
+
+	sort.Strings(names)
+
+	var buf []string
+
+	for _, item := range names {
+		name := filepath.Join(dir, filepath.Base(item))
+		if err := os.WriteFile(name, content.Attachments[item], 0o600); err != nil {
+			_ = os.RemoveAll(dir)
+			return nil, errors.Wrap(err, "failed to write")
+		}
+		buf = append(buf, name)
+	}
+
+	return buf, nil
+}
+
+// cleanAttachments removes the attachments of sight left over tempExpiry (e.g., not removed
+// by the caller, or by a server stopped), so that they do not grow without bound.
+func (m *mail) cleanAttachments(sight string) {
+	m.cfg.Logger.Debug("mail: cleanAttachments")
+
+	dirs, _ := filepath.Glob(filepath.Join(os.TempDir(), tempPattern+sight+"-*"))
+
+	for _, item := range dirs {
+		info, err := os.Stat(item)
+		if err != nil || !info.IsDir() || time.Since(info.ModTime()) < tempExpiry {
+			continue
+		}
+		_ = os.RemoveAll(item)
+	}
+}
+
+// Addresses returns the unique email addresses in the identities (e.g., "name <name@example.com>"
+// or "name@example.com"), the invalid ones are skipped.
+func Addresses(idents ...string) []string {
+	var buf []string
+
+	for _, item := range idents {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		addr, err := netmail.ParseAddress(item)
+		if err != nil {
+			continue
+		}
+		if !slices.Contains(buf, addr.Address) {
+			buf = append(buf, addr.Address)
+		}
+	}
+
+	return buf
+}
+
+// HtmlBody returns the html body of the plain text body, which is escaped and preformatted.
+func HtmlBody(data string) string {
+	return htmlPrefix + htmltemplate.HTMLEscapeString(data) + htmlSuffix
+}
+
+func renderText(data string, content *Content) (string, error) {
+	var buf bytes.Buffer
+
+	t, err := template.New("mail").Parse(data)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse")
+	}
+
+	if err := t.Execute(&buf, content); err != nil {
+		return "", errors.Wrap(err, "failed to execute")
+	}
+
+	return buf.String(), nil
+}
+
+func renderHtml(data string, content *Content) (string, error) {
+	var buf bytes.Buffer
+
+	t, err := htmltemplate.New("mail").Parse(data)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to parse")
+	}
+
+	if err := t.Execute(&buf, content); err != nil {
+		return "", errors.Wrap(err, "failed to execute")
+	}
+
+	return buf.String(), nil
+}
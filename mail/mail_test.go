@@ -0,0 +1,158 @@
+package mail
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/devops-pipeflow/insight-plugin/config"
+	"github.com/devops-pipeflow/insight-plugin/proto"
+)
+
+func initMail() mail {
+	m := mail{
+		cfg: DefaultConfig(),
+	}
+
+	m.cfg.Config = config.Config{}
+	m.cfg.Logger = hclog.New(&hclog.LoggerOptions{
+		Name:  "mail",
+		Level: hclog.LevelFromString("INFO"),
+	})
+
+	return m
+}
+
+func TestRun(t *testing.T) {
+	ctx := context.Background()
+	m := initMail()
+
+	t.Setenv("TMPDIR", t.TempDir())
+
+	err := m.Init(ctx)
+	assert.Equal(t, nil, err)
+
+	content := Content{
+		Sight: "buildsight",
+		Title: "build failed",
+		Data: &proto.BuildInfo{
+			RootCause:  "foo <bar>",
+			Suggestion: "baz",
+		},
+		ToAddresses: []string{"owner <owner@example.com>", "owner@example.com"},
+		CcAddresses: []string{"owner@example.com", "author@example.com", "invalid"},
+		Attachments: map[string][]byte{"errors.txt": []byte("error")},
+	}
+
+	ret, err := m.Run(ctx, &content)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, ContentTypePlain, ret.ContentType)
+	assert.Equal(t, fromAddress, ret.FromAddress)
+	assert.Equal(t, "[buildsight]: build failed", ret.Subject)
+	assert.Equal(t, true, strings.Contains(ret.Body, "foo <bar>"))
+	assert.Equal(t, []string{"owner@example.com"}, ret.ToAddresses)
+	assert.Equal(t, []string{"author@example.com"}, ret.CcAddresses)
+	assert.Equal(t, 1, len(ret.Attachments))
+
+	buf, err := os.ReadFile(ret.Attachments[0])
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "error", string(buf))
+
+	m.cfg.Config.Spec.MailConfig = config.MailConfig{
+		ContentType: ContentTypeHtml,
+		FromAddress: "insight",
+	}
+
+	ret, err = m.Run(ctx, &content)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, ContentTypeHtml, ret.ContentType)
+	assert.Equal(t, "insight", ret.FromAddress)
+	assert.Equal(t, true, strings.HasPrefix(ret.Body, htmlPrefix))
+	assert.Equal(t, true, strings.Contains(ret.Body, "foo &lt;bar&gt;"))
+
+	m.cfg.Config.Spec.MailConfig.Templates = []config.MailTemplate{
+		{
+			Name:    "buildsight",
+			Subject: "[team]:\n{{.Title}}",
+			Body:    "<p>{{.Data.RootCause}}</p>",
+		},
+	}
+
+	ret, err = m.Run(ctx, &content)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "[team]: build failed", ret.Subject)
+	assert.Equal(t, "<p>foo &lt;bar&gt;</p>", ret.Body)
+
+	m.cfg.Config.Spec.MailConfig.Templates[0].Body = "{{.Invalid"
+
+	_, err = m.Run(ctx, &content)
+	assert.NotEqual(t, nil, err)
+
+	err = m.Deinit(ctx)
+	assert.Equal(t, nil, err)
+
+	_, err = os.Stat(ret.Attachments[0])
+	assert.Equal(t, nil, err)
+}
+
+func TestWriteAttachments(t *testing.T) {
+	ctx := context.Background()
+	m := initMail()
+
+	_ = m.Init(ctx)
+
+	defer func() {
+		_ = m.Deinit(ctx)
+	}()
+
+	t.Setenv("TMPDIR", t.TempDir())
+
+	expired, _ := os.MkdirTemp("", tempPattern+"nodesight-")
+	other, _ := os.MkdirTemp("", tempPattern+"buildsight-")
+
+	past := time.Now().Add(-tempExpiry - time.Hour)
+	_ = os.Chtimes(expired, past, past)
+	_ = os.Chtimes(other, past, past)
+
+	ret, err := m.writeAttachments(&Content{Sight: "nodesight"})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(ret))
+
+	ret, err = m.writeAttachments(&Content{
+		Sight: "nodesight",
+		Attachments: map[string][]byte{
+			"foo.json":      []byte("{}"),
+			"../bar.txt":    []byte("bar"),
+			"nodestat.json": []byte("{}"),
+		},
+	})
+
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 3, len(ret))
+	assert.Equal(t, "bar.txt", filepath.Base(ret[0]))
+	assert.Equal(t, os.TempDir(), filepath.Dir(filepath.Dir(ret[0])))
+
+	_, err = os.Stat(expired)
+	assert.Equal(t, true, os.IsNotExist(err))
+
+	_, err = os.Stat(other)
+	assert.Equal(t, nil, err)
+}
+
+func TestHtmlBody(t *testing.T) {
+	assert.Equal(t, "<html><body><pre>a &lt; b</pre></body></html>", HtmlBody("a < b"))
+}
+
+func TestAddresses(t *testing.T) {
+	ret := Addresses()
+	assert.Equal(t, 0, len(ret))
+
+	ret = Addresses("", "invalid", "foo <foo@example.com>", "foo@example.com", " bar@example.com ")
+	assert.Equal(t, []string{"foo@example.com", "bar@example.com"}, ret)
+}
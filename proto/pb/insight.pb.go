@@ -0,0 +1,6618 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.33.0
+// 	protoc        (unknown)
+// source: insight.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type ConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BuildConfig     *BuildConfig     `protobuf:"bytes,1,opt,name=buildConfig,proto3" json:"buildConfig,omitempty"`         // buildsight config
+	CodeConfig      *CodeConfig      `protobuf:"bytes,2,opt,name=codeConfig,proto3" json:"codeConfig,omitempty"`           // codesight config
+	NodeConfig      *NodeConfig      `protobuf:"bytes,3,opt,name=nodeConfig,proto3" json:"nodeConfig,omitempty"`           // nodesight config
+	ToolchainConfig *ToolchainConfig `protobuf:"bytes,4,opt,name=toolchainConfig,proto3" json:"toolchainConfig,omitempty"` // toolchainsight config
+	ArtifactConfig  *ArtifactConfig  `protobuf:"bytes,5,opt,name=artifactConfig,proto3" json:"artifactConfig,omitempty"`   // artifactory config
+	GptConfig       *GptConfig       `protobuf:"bytes,6,opt,name=gptConfig,proto3" json:"gptConfig,omitempty"`             // gpt config
+	RepoConfig      *RepoConfig      `protobuf:"bytes,7,opt,name=repoConfig,proto3" json:"repoConfig,omitempty"`           // repo config
+	ReviewConfig    *ReviewConfig    `protobuf:"bytes,8,opt,name=reviewConfig,proto3" json:"reviewConfig,omitempty"`       // review config
+}
+
+func (x *ConfigRequest) Reset() {
+	*x = ConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigRequest) ProtoMessage() {}
+
+func (x *ConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigRequest.ProtoReflect.Descriptor instead.
+func (*ConfigRequest) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *ConfigRequest) GetBuildConfig() *BuildConfig {
+	if x != nil {
+		return x.BuildConfig
+	}
+	return nil
+}
+
+func (x *ConfigRequest) GetCodeConfig() *CodeConfig {
+	if x != nil {
+		return x.CodeConfig
+	}
+	return nil
+}
+
+func (x *ConfigRequest) GetNodeConfig() *NodeConfig {
+	if x != nil {
+		return x.NodeConfig
+	}
+	return nil
+}
+
+func (x *ConfigRequest) GetToolchainConfig() *ToolchainConfig {
+	if x != nil {
+		return x.ToolchainConfig
+	}
+	return nil
+}
+
+func (x *ConfigRequest) GetArtifactConfig() *ArtifactConfig {
+	if x != nil {
+		return x.ArtifactConfig
+	}
+	return nil
+}
+
+func (x *ConfigRequest) GetGptConfig() *GptConfig {
+	if x != nil {
+		return x.GptConfig
+	}
+	return nil
+}
+
+func (x *ConfigRequest) GetRepoConfig() *RepoConfig {
+	if x != nil {
+		return x.RepoConfig
+	}
+	return nil
+}
+
+func (x *ConfigRequest) GetReviewConfig() *ReviewConfig {
+	if x != nil {
+		return x.ReviewConfig
+	}
+	return nil
+}
+
+type BuildConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoggingConfig *LoggingConfig `protobuf:"bytes,1,opt,name=loggingConfig,proto3" json:"loggingConfig,omitempty"` // logging config
+	Duration      string         `protobuf:"bytes,2,opt,name=duration,proto3" json:"duration,omitempty"`           // duration time in string (h:hour, m:minute, s:second)
+}
+
+func (x *BuildConfig) Reset() {
+	*x = BuildConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildConfig) ProtoMessage() {}
+
+func (x *BuildConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildConfig.ProtoReflect.Descriptor instead.
+func (*BuildConfig) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *BuildConfig) GetLoggingConfig() *LoggingConfig {
+	if x != nil {
+		return x.LoggingConfig
+	}
+	return nil
+}
+
+func (x *BuildConfig) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+type CodeConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Duration    string        `protobuf:"bytes,1,opt,name=duration,proto3" json:"duration,omitempty"`       // duration time in string (h:hour, m:minute, s:second)
+	LintConfigs []*LintConfig `protobuf:"bytes,2,rep,name=lintConfigs,proto3" json:"lintConfigs,omitempty"` // lint configs
+	LintVote    *LintVote     `protobuf:"bytes,3,opt,name=lintVote,proto3" json:"lintVote,omitempty"`       // vote config (Gerrit, pingview)
+	MegaConfig  *MegaConfig   `protobuf:"bytes,4,opt,name=megaConfig,proto3" json:"megaConfig,omitempty"`   // megalinter image config
+}
+
+func (x *CodeConfig) Reset() {
+	*x = CodeConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CodeConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodeConfig) ProtoMessage() {}
+
+func (x *CodeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodeConfig.ProtoReflect.Descriptor instead.
+func (*CodeConfig) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CodeConfig) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+func (x *CodeConfig) GetLintConfigs() []*LintConfig {
+	if x != nil {
+		return x.LintConfigs
+	}
+	return nil
+}
+
+func (x *CodeConfig) GetLintVote() *LintVote {
+	if x != nil {
+		return x.LintVote
+	}
+	return nil
+}
+
+func (x *CodeConfig) GetMegaConfig() *MegaConfig {
+	if x != nil {
+		return x.MegaConfig
+	}
+	return nil
+}
+
+type NodeConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Duration string `protobuf:"bytes,1,opt,name=duration,proto3" json:"duration,omitempty"` // duration time in string (h:hour, m:minute, s:second)
+}
+
+func (x *NodeConfig) Reset() {
+	*x = NodeConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeConfig) ProtoMessage() {}
+
+func (x *NodeConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeConfig.ProtoReflect.Descriptor instead.
+func (*NodeConfig) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *NodeConfig) GetDuration() string {
+	if x != nil {
+		return x.Duration
+	}
+	return ""
+}
+
+type ToolchainConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ToolchainConfig) Reset() {
+	*x = ToolchainConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ToolchainConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolchainConfig) ProtoMessage() {}
+
+func (x *ToolchainConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolchainConfig.ProtoReflect.Descriptor instead.
+func (*ToolchainConfig) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{4}
+}
+
+type ArtifactConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url  string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`   // artifactory url
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"` // artifactory user
+	Pass string `protobuf:"bytes,3,opt,name=pass,proto3" json:"pass,omitempty"` // artifactory pass
+}
+
+func (x *ArtifactConfig) Reset() {
+	*x = ArtifactConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtifactConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtifactConfig) ProtoMessage() {}
+
+func (x *ArtifactConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtifactConfig.ProtoReflect.Descriptor instead.
+func (*ArtifactConfig) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ArtifactConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ArtifactConfig) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ArtifactConfig) GetPass() string {
+	if x != nil {
+		return x.Pass
+	}
+	return ""
+}
+
+type GptConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url  string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`   // gpt url (codegpt)
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"` // gpt user (codegpt)
+	Pass string `protobuf:"bytes,3,opt,name=pass,proto3" json:"pass,omitempty"` // gpt pass (codegpt)
+}
+
+func (x *GptConfig) Reset() {
+	*x = GptConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GptConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GptConfig) ProtoMessage() {}
+
+func (x *GptConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GptConfig.ProtoReflect.Descriptor instead.
+func (*GptConfig) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *GptConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *GptConfig) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *GptConfig) GetPass() string {
+	if x != nil {
+		return x.Pass
+	}
+	return ""
+}
+
+type RepoConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url  string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`   // repo url (Gitiles)
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"` // repo user (Gitiles)
+	Pass string `protobuf:"bytes,3,opt,name=pass,proto3" json:"pass,omitempty"` // repo pass (Gitiles)
+}
+
+func (x *RepoConfig) Reset() {
+	*x = RepoConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepoConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoConfig) ProtoMessage() {}
+
+func (x *RepoConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoConfig.ProtoReflect.Descriptor instead.
+func (*RepoConfig) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *RepoConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *RepoConfig) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *RepoConfig) GetPass() string {
+	if x != nil {
+		return x.Pass
+	}
+	return ""
+}
+
+type ReviewConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url  string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`   // review url (Gerrit, pingview)
+	User string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"` // review user (Gerrit, pingview)
+	Pass string `protobuf:"bytes,3,opt,name=pass,proto3" json:"pass,omitempty"` // review pass (Gerrit, pingview)
+}
+
+func (x *ReviewConfig) Reset() {
+	*x = ReviewConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewConfig) ProtoMessage() {}
+
+func (x *ReviewConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewConfig.ProtoReflect.Descriptor instead.
+func (*ReviewConfig) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReviewConfig) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ReviewConfig) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *ReviewConfig) GetPass() string {
+	if x != nil {
+		return x.Pass
+	}
+	return ""
+}
+
+type LoggingConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start int64 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"` // logging lines start (>=1) if not set by LoggingTrigger
+	Len   int64 `protobuf:"varint,2,opt,name=len,proto3" json:"len,omitempty"`     // logging lines per region (0: 20)
+	Count int64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"` // logging regions at most (0: 10, total size: len*count)
+}
+
+func (x *LoggingConfig) Reset() {
+	*x = LoggingConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoggingConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoggingConfig) ProtoMessage() {}
+
+func (x *LoggingConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoggingConfig.ProtoReflect.Descriptor instead.
+func (*LoggingConfig) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *LoggingConfig) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *LoggingConfig) GetLen() int64 {
+	if x != nil {
+		return x.Len
+	}
+	return 0
+}
+
+func (x *LoggingConfig) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type LintConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name       string      `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`             // lint name
+	Extensions []string    `protobuf:"bytes,2,rep,name=extensions,proto3" json:"extensions,omitempty"` // extension names
+	Files      []string    `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"`           // file names
+	Projects   []string    `protobuf:"bytes,4,rep,name=projects,proto3" json:"projects,omitempty"`     // project names
+	Rules      []*LintRule `protobuf:"bytes,5,rep,name=rules,proto3" json:"rules,omitempty"`           // lint rules
+}
+
+func (x *LintConfig) Reset() {
+	*x = LintConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LintConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintConfig) ProtoMessage() {}
+
+func (x *LintConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintConfig.ProtoReflect.Descriptor instead.
+func (*LintConfig) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LintConfig) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LintConfig) GetExtensions() []string {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+func (x *LintConfig) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *LintConfig) GetProjects() []string {
+	if x != nil {
+		return x.Projects
+	}
+	return nil
+}
+
+func (x *LintConfig) GetRules() []*LintRule {
+	if x != nil {
+		return x.Rules
+	}
+	return nil
+}
+
+type LintRule struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name           string         `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`                      // rule name
+	Disabled       bool           `protobuf:"varint,2,opt,name=disabled,proto3" json:"disabled,omitempty"`             // disable rule
+	Extensions     []string       `protobuf:"bytes,3,rep,name=extensions,proto3" json:"extensions,omitempty"`          // extension names (empty: default of rule)
+	Files          []string       `protobuf:"bytes,4,rep,name=files,proto3" json:"files,omitempty"`                    // file names (empty: default of rule)
+	SubjectMin     int64          `protobuf:"varint,5,opt,name=subjectMin,proto3" json:"subjectMin,omitempty"`         // subject min length of message (0: 25)
+	SubjectMax     int64          `protobuf:"varint,6,opt,name=subjectMax,proto3" json:"subjectMax,omitempty"`         // subject max length of message (0: 80)
+	DescriptionMax int64          `protobuf:"varint,7,opt,name=descriptionMax,proto3" json:"descriptionMax,omitempty"` // description max length of message (0: 80)
+	SubjectStyle   string         `protobuf:"bytes,8,opt,name=subjectStyle,proto3" json:"subjectStyle,omitempty"`      // subject style of message (conventional, module; empty: any)
+	Trailers       []string       `protobuf:"bytes,9,rep,name=trailers,proto3" json:"trailers,omitempty"`              // required trailers of message (e.g., Signed-off-by, Bug, Test)
+	Allowlist      *LintAllowlist `protobuf:"bytes,10,opt,name=allowlist,proto3" json:"allowlist,omitempty"`           // allowed paths and values in regexp
+	License        string         `protobuf:"bytes,11,opt,name=license,proto3" json:"license,omitempty"`               // SPDX license expression of header (e.g., Apache-2.0)
+	Copyright      string         `protobuf:"bytes,12,opt,name=copyright,proto3" json:"copyright,omitempty"`           // copyright holder of header
+	Header         string         `protobuf:"bytes,13,opt,name=header,proto3" json:"header,omitempty"`                 // header template with {{.License}}, {{.Copyright}} and {{.Year}} (empty: default of rule)
+	SizeMax        int64          `protobuf:"varint,14,opt,name=sizeMax,proto3" json:"sizeMax,omitempty"`              // max file size in bytes (0: 1048576)
+	Denylist       []string       `protobuf:"bytes,15,rep,name=denylist,proto3" json:"denylist,omitempty"`             // disallowed extension names (empty: default of rule)
+	IllegalChars   string         `protobuf:"bytes,16,opt,name=illegalChars,proto3" json:"illegalChars,omitempty"`     // illegal characters of path names (empty: \:*?"<>|)
+	Prompt         string         `protobuf:"bytes,17,opt,name=prompt,proto3" json:"prompt,omitempty"`                 // prompt template of gptlinter (Go template, empty: default)
+	Tokens         int64          `protobuf:"varint,18,opt,name=tokens,proto3" json:"tokens,omitempty"`                // token budget per file of gptlinter (0: 2000)
+}
+
+func (x *LintRule) Reset() {
+	*x = LintRule{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LintRule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintRule) ProtoMessage() {}
+
+func (x *LintRule) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintRule.ProtoReflect.Descriptor instead.
+func (*LintRule) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *LintRule) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LintRule) GetDisabled() bool {
+	if x != nil {
+		return x.Disabled
+	}
+	return false
+}
+
+func (x *LintRule) GetExtensions() []string {
+	if x != nil {
+		return x.Extensions
+	}
+	return nil
+}
+
+func (x *LintRule) GetFiles() []string {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+func (x *LintRule) GetSubjectMin() int64 {
+	if x != nil {
+		return x.SubjectMin
+	}
+	return 0
+}
+
+func (x *LintRule) GetSubjectMax() int64 {
+	if x != nil {
+		return x.SubjectMax
+	}
+	return 0
+}
+
+func (x *LintRule) GetDescriptionMax() int64 {
+	if x != nil {
+		return x.DescriptionMax
+	}
+	return 0
+}
+
+func (x *LintRule) GetSubjectStyle() string {
+	if x != nil {
+		return x.SubjectStyle
+	}
+	return ""
+}
+
+func (x *LintRule) GetTrailers() []string {
+	if x != nil {
+		return x.Trailers
+	}
+	return nil
+}
+
+func (x *LintRule) GetAllowlist() *LintAllowlist {
+	if x != nil {
+		return x.Allowlist
+	}
+	return nil
+}
+
+func (x *LintRule) GetLicense() string {
+	if x != nil {
+		return x.License
+	}
+	return ""
+}
+
+func (x *LintRule) GetCopyright() string {
+	if x != nil {
+		return x.Copyright
+	}
+	return ""
+}
+
+func (x *LintRule) GetHeader() string {
+	if x != nil {
+		return x.Header
+	}
+	return ""
+}
+
+func (x *LintRule) GetSizeMax() int64 {
+	if x != nil {
+		return x.SizeMax
+	}
+	return 0
+}
+
+func (x *LintRule) GetDenylist() []string {
+	if x != nil {
+		return x.Denylist
+	}
+	return nil
+}
+
+func (x *LintRule) GetIllegalChars() string {
+	if x != nil {
+		return x.IllegalChars
+	}
+	return ""
+}
+
+func (x *LintRule) GetPrompt() string {
+	if x != nil {
+		return x.Prompt
+	}
+	return ""
+}
+
+func (x *LintRule) GetTokens() int64 {
+	if x != nil {
+		return x.Tokens
+	}
+	return 0
+}
+
+type LintAllowlist struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Paths   []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`     // allowed paths in regexp
+	Regexes []string `protobuf:"bytes,2,rep,name=regexes,proto3" json:"regexes,omitempty"` // allowed values in regexp (secrets of secretlinter, types of sepolicy)
+}
+
+func (x *LintAllowlist) Reset() {
+	*x = LintAllowlist{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LintAllowlist) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintAllowlist) ProtoMessage() {}
+
+func (x *LintAllowlist) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintAllowlist.ProtoReflect.Descriptor instead.
+func (*LintAllowlist) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *LintAllowlist) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+func (x *LintAllowlist) GetRegexes() []string {
+	if x != nil {
+		return x.Regexes
+	}
+	return nil
+}
+
+type LintVote struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Approval    string       `protobuf:"bytes,1,opt,name=approval,proto3" json:"approval,omitempty"`        // approval vote
+	Disapproval string       `protobuf:"bytes,2,opt,name=disapproval,proto3" json:"disapproval,omitempty"`  // disapproval vote
+	Label       string       `protobuf:"bytes,3,opt,name=label,proto3" json:"label,omitempty"`              // vote label
+	Message     string       `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`          // vote message
+	Labels      []*LintLabel `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty"`            // more vote labels
+	CommentOnly bool         `protobuf:"varint,6,opt,name=commentOnly,proto3" json:"commentOnly,omitempty"` // comment without vote
+}
+
+func (x *LintVote) Reset() {
+	*x = LintVote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LintVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintVote) ProtoMessage() {}
+
+func (x *LintVote) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintVote.ProtoReflect.Descriptor instead.
+func (*LintVote) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *LintVote) GetApproval() string {
+	if x != nil {
+		return x.Approval
+	}
+	return ""
+}
+
+func (x *LintVote) GetDisapproval() string {
+	if x != nil {
+		return x.Disapproval
+	}
+	return ""
+}
+
+func (x *LintVote) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *LintVote) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LintVote) GetLabels() []*LintLabel {
+	if x != nil {
+		return x.Labels
+	}
+	return nil
+}
+
+func (x *LintVote) GetCommentOnly() bool {
+	if x != nil {
+		return x.CommentOnly
+	}
+	return false
+}
+
+type LintLabel struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`               // vote label
+	Approval    string `protobuf:"bytes,2,opt,name=approval,proto3" json:"approval,omitempty"`       // approval vote (empty: approval of LintVote)
+	Disapproval string `protobuf:"bytes,3,opt,name=disapproval,proto3" json:"disapproval,omitempty"` // disapproval vote (empty: disapproval of LintVote)
+}
+
+func (x *LintLabel) Reset() {
+	*x = LintLabel{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LintLabel) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LintLabel) ProtoMessage() {}
+
+func (x *LintLabel) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LintLabel.ProtoReflect.Descriptor instead.
+func (*LintLabel) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *LintLabel) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LintLabel) GetApproval() string {
+	if x != nil {
+		return x.Approval
+	}
+	return ""
+}
+
+func (x *LintLabel) GetDisapproval() string {
+	if x != nil {
+		return x.Disapproval
+	}
+	return ""
+}
+
+type MegaConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Registry   string `protobuf:"bytes,1,opt,name=registry,proto3" json:"registry,omitempty"`     // image registry (empty: docker.io)
+	Image      string `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`           // image name (empty: oxsecurity/megalinter-cupcake)
+	Tag        string `protobuf:"bytes,3,opt,name=tag,proto3" json:"tag,omitempty"`               // image tag (empty: latest)
+	Digest     string `protobuf:"bytes,4,opt,name=digest,proto3" json:"digest,omitempty"`         // image digest (e.g., sha256:...)
+	PullPolicy string `protobuf:"bytes,5,opt,name=pullPolicy,proto3" json:"pullPolicy,omitempty"` // pull policy (always, if-not-present, never; empty: if-not-present)
+}
+
+func (x *MegaConfig) Reset() {
+	*x = MegaConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MegaConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MegaConfig) ProtoMessage() {}
+
+func (x *MegaConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MegaConfig.ProtoReflect.Descriptor instead.
+func (*MegaConfig) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *MegaConfig) GetRegistry() string {
+	if x != nil {
+		return x.Registry
+	}
+	return ""
+}
+
+func (x *MegaConfig) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *MegaConfig) GetTag() string {
+	if x != nil {
+		return x.Tag
+	}
+	return ""
+}
+
+func (x *MegaConfig) GetDigest() string {
+	if x != nil {
+		return x.Digest
+	}
+	return ""
+}
+
+func (x *MegaConfig) GetPullPolicy() string {
+	if x != nil {
+		return x.PullPolicy
+	}
+	return ""
+}
+
+type ConfigResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ConfigResponse) Reset() {
+	*x = ConfigResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfigResponse) ProtoMessage() {}
+
+func (x *ConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfigResponse.ProtoReflect.Descriptor instead.
+func (*ConfigResponse) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{16}
+}
+
+type TriggerRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArtifactTrigger  *ArtifactTrigger  `protobuf:"bytes,1,opt,name=artifactTrigger,proto3" json:"artifactTrigger,omitempty"`   // artifactsight trigger
+	BuildTrigger     *BuildTrigger     `protobuf:"bytes,2,opt,name=buildTrigger,proto3" json:"buildTrigger,omitempty"`         // buildsight trigger
+	CodeTrigger      *CodeTrigger      `protobuf:"bytes,3,opt,name=codeTrigger,proto3" json:"codeTrigger,omitempty"`           // codesight trigger
+	NodeTrigger      *NodeTrigger      `protobuf:"bytes,4,opt,name=nodeTrigger,proto3" json:"nodeTrigger,omitempty"`           // nodesight trigger
+	ToolchainTrigger *ToolchainTrigger `protobuf:"bytes,5,opt,name=toolchainTrigger,proto3" json:"toolchainTrigger,omitempty"` // toolchainsight trigger
+}
+
+func (x *TriggerRequest) Reset() {
+	*x = TriggerRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerRequest) ProtoMessage() {}
+
+func (x *TriggerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerRequest.ProtoReflect.Descriptor instead.
+func (*TriggerRequest) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *TriggerRequest) GetArtifactTrigger() *ArtifactTrigger {
+	if x != nil {
+		return x.ArtifactTrigger
+	}
+	return nil
+}
+
+func (x *TriggerRequest) GetBuildTrigger() *BuildTrigger {
+	if x != nil {
+		return x.BuildTrigger
+	}
+	return nil
+}
+
+func (x *TriggerRequest) GetCodeTrigger() *CodeTrigger {
+	if x != nil {
+		return x.CodeTrigger
+	}
+	return nil
+}
+
+func (x *TriggerRequest) GetNodeTrigger() *NodeTrigger {
+	if x != nil {
+		return x.NodeTrigger
+	}
+	return nil
+}
+
+func (x *TriggerRequest) GetToolchainTrigger() *ToolchainTrigger {
+	if x != nil {
+		return x.ToolchainTrigger
+	}
+	return nil
+}
+
+type ArtifactTrigger struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ArtifactTrigger) Reset() {
+	*x = ArtifactTrigger{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtifactTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtifactTrigger) ProtoMessage() {}
+
+func (x *ArtifactTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtifactTrigger.ProtoReflect.Descriptor instead.
+func (*ArtifactTrigger) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{18}
+}
+
+type BuildTrigger struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	EnvVariables   []*EnvVariable  `protobuf:"bytes,1,rep,name=envVariables,proto3" json:"envVariables,omitempty"`     // environment variables in list
+	LoggingTrigger *LoggingTrigger `protobuf:"bytes,2,opt,name=loggingTrigger,proto3" json:"loggingTrigger,omitempty"` // logging trigger
+	ReviewTrigger  *ReviewTrigger  `protobuf:"bytes,3,opt,name=reviewTrigger,proto3" json:"reviewTrigger,omitempty"`   // review trigger
+}
+
+func (x *BuildTrigger) Reset() {
+	*x = BuildTrigger{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildTrigger) ProtoMessage() {}
+
+func (x *BuildTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildTrigger.ProtoReflect.Descriptor instead.
+func (*BuildTrigger) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *BuildTrigger) GetEnvVariables() []*EnvVariable {
+	if x != nil {
+		return x.EnvVariables
+	}
+	return nil
+}
+
+func (x *BuildTrigger) GetLoggingTrigger() *LoggingTrigger {
+	if x != nil {
+		return x.LoggingTrigger
+	}
+	return nil
+}
+
+func (x *BuildTrigger) GetReviewTrigger() *ReviewTrigger {
+	if x != nil {
+		return x.ReviewTrigger
+	}
+	return nil
+}
+
+type CodeTrigger struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ReviewTrigger *ReviewTrigger `protobuf:"bytes,1,opt,name=reviewTrigger,proto3" json:"reviewTrigger,omitempty"` // review trigger
+}
+
+func (x *CodeTrigger) Reset() {
+	*x = CodeTrigger{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CodeTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodeTrigger) ProtoMessage() {}
+
+func (x *CodeTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodeTrigger.ProtoReflect.Descriptor instead.
+func (*CodeTrigger) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CodeTrigger) GetReviewTrigger() *ReviewTrigger {
+	if x != nil {
+		return x.ReviewTrigger
+	}
+	return nil
+}
+
+type NodeTrigger struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SshConfig *SshConfig `protobuf:"bytes,1,opt,name=sshConfig,proto3" json:"sshConfig,omitempty"` // ssh config
+}
+
+func (x *NodeTrigger) Reset() {
+	*x = NodeTrigger{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeTrigger) ProtoMessage() {}
+
+func (x *NodeTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeTrigger.ProtoReflect.Descriptor instead.
+func (*NodeTrigger) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *NodeTrigger) GetSshConfig() *SshConfig {
+	if x != nil {
+		return x.SshConfig
+	}
+	return nil
+}
+
+type ToolchainTrigger struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ToolchainTrigger) Reset() {
+	*x = ToolchainTrigger{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ToolchainTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolchainTrigger) ProtoMessage() {}
+
+func (x *ToolchainTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolchainTrigger.ProtoReflect.Descriptor instead.
+func (*ToolchainTrigger) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{22}
+}
+
+type EnvVariable struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`   // variable name
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"` // variable value
+}
+
+func (x *EnvVariable) Reset() {
+	*x = EnvVariable{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *EnvVariable) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnvVariable) ProtoMessage() {}
+
+func (x *EnvVariable) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnvVariable.ProtoReflect.Descriptor instead.
+func (*EnvVariable) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *EnvVariable) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *EnvVariable) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type LoggingTrigger struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Lines []string `protobuf:"bytes,1,rep,name=lines,proto3" json:"lines,omitempty"`  // logging lines in list
+	Start int64    `protobuf:"varint,2,opt,name=start,proto3" json:"start,omitempty"` // logging lines start (>=1)
+	Len   int64    `protobuf:"varint,3,opt,name=len,proto3" json:"len,omitempty"`     // logging lines of window scanned (0: till the end)
+}
+
+func (x *LoggingTrigger) Reset() {
+	*x = LoggingTrigger{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoggingTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoggingTrigger) ProtoMessage() {}
+
+func (x *LoggingTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoggingTrigger.ProtoReflect.Descriptor instead.
+func (*LoggingTrigger) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *LoggingTrigger) GetLines() []string {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *LoggingTrigger) GetStart() int64 {
+	if x != nil {
+		return x.Start
+	}
+	return 0
+}
+
+func (x *LoggingTrigger) GetLen() int64 {
+	if x != nil {
+		return x.Len
+	}
+	return 0
+}
+
+type ReviewTrigger struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host                  string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	Port                  string `protobuf:"bytes,2,opt,name=port,proto3" json:"port,omitempty"`
+	Project               string `protobuf:"bytes,3,opt,name=project,proto3" json:"project,omitempty"`
+	Topic                 string `protobuf:"bytes,4,opt,name=topic,proto3" json:"topic,omitempty"`
+	Branch                string `protobuf:"bytes,5,opt,name=branch,proto3" json:"branch,omitempty"`
+	EventType             string `protobuf:"bytes,6,opt,name=eventType,proto3" json:"eventType,omitempty"`
+	Scheme                string `protobuf:"bytes,7,opt,name=scheme,proto3" json:"scheme,omitempty"`
+	Refspec               string `protobuf:"bytes,8,opt,name=refspec,proto3" json:"refspec,omitempty"`
+	ChangeID              string `protobuf:"bytes,9,opt,name=changeID,proto3" json:"changeID,omitempty"`
+	ChangeUrl             string `protobuf:"bytes,10,opt,name=changeUrl,proto3" json:"changeUrl,omitempty"`
+	ChangeNumber          string `protobuf:"bytes,11,opt,name=changeNumber,proto3" json:"changeNumber,omitempty"`
+	ChangeSubject         string `protobuf:"bytes,12,opt,name=changeSubject,proto3" json:"changeSubject,omitempty"`
+	ChangeOwner           string `protobuf:"bytes,13,opt,name=changeOwner,proto3" json:"changeOwner,omitempty"`
+	ChangeOwnerName       string `protobuf:"bytes,14,opt,name=changeOwnerName,proto3" json:"changeOwnerName,omitempty"`
+	ChangeOwnerEmail      string `protobuf:"bytes,15,opt,name=changeOwnerEmail,proto3" json:"changeOwnerEmail,omitempty"`
+	ChangeWIPState        string `protobuf:"bytes,16,opt,name=changeWIPState,proto3" json:"changeWIPState,omitempty"`
+	ChangePrivateState    string `protobuf:"bytes,17,opt,name=changePrivateState,proto3" json:"changePrivateState,omitempty"`
+	ChangeCommitMessage   string `protobuf:"bytes,18,opt,name=changeCommitMessage,proto3" json:"changeCommitMessage,omitempty"`
+	PatchsetNumber        string `protobuf:"bytes,19,opt,name=patchsetNumber,proto3" json:"patchsetNumber,omitempty"`
+	PatchsetRevision      string `protobuf:"bytes,20,opt,name=patchsetRevision,proto3" json:"patchsetRevision,omitempty"`
+	PatchsetUploader      string `protobuf:"bytes,21,opt,name=patchsetUploader,proto3" json:"patchsetUploader,omitempty"`
+	PatchsetUploaderName  string `protobuf:"bytes,22,opt,name=patchsetUploaderName,proto3" json:"patchsetUploaderName,omitempty"`
+	PatchsetUploaderEmail string `protobuf:"bytes,23,opt,name=patchsetUploaderEmail,proto3" json:"patchsetUploaderEmail,omitempty"`
+}
+
+func (x *ReviewTrigger) Reset() {
+	*x = ReviewTrigger{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewTrigger) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewTrigger) ProtoMessage() {}
+
+func (x *ReviewTrigger) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewTrigger.ProtoReflect.Descriptor instead.
+func (*ReviewTrigger) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ReviewTrigger) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *ReviewTrigger) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *ReviewTrigger) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ReviewTrigger) GetTopic() string {
+	if x != nil {
+		return x.Topic
+	}
+	return ""
+}
+
+func (x *ReviewTrigger) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *ReviewTrigger) GetEventType() string {
+	if x != nil {
+		return x.EventType
+	}
+	return ""
+}
+
+func (x *ReviewTrigger) GetScheme() string {
+	if x != nil {
+		return x.Scheme
+	}
+	return ""
+}
+
+func (x *ReviewTrigger) GetRefspec() string {
+	if x != nil {
+		return x.Refspec
+	}
+	return ""
+}
+
+func (x *ReviewTrigger) GetChangeID() string {
+	if x != nil {
+		return x.ChangeID
+	}
+	return ""
+}
+
+func (x *ReviewTrigger) GetChangeUrl() string {
+	if x != nil {
+		return x.ChangeUrl
+	}
+	return ""
+}
+
+func (x *ReviewTrigger) GetChangeNumber() string {
+	if x != nil {
+		return x.ChangeNumber
+	}
+	return ""
+}
+
+func (x *ReviewTrigger) GetChangeSubject() string {
+	if x != nil {
+		return x.ChangeSubject
+	}
+	return ""
+}
+
+func (x *ReviewTrigger) GetChangeOwner() string {
+	if x != nil {
+		return x.ChangeOwner
+	}
+	return ""
+}
+
+func (x *ReviewTrigger) GetChangeOwnerName() string {
+	if x != nil {
+		return x.ChangeOwnerName
+	}
+	return ""
+}
+
+func (x *ReviewTrigger) GetChangeOwnerEmail() string {
+	if x != nil {
+		return x.ChangeOwnerEmail
+	}
+	return ""
+}
+
+func (x *ReviewTrigger) GetChangeWIPState() string {
+	if x != nil {
+		return x.ChangeWIPState
+	}
+	return ""
+}
+
+func (x *ReviewTrigger) GetChangePrivateState() string {
+	if x != nil {
+		return x.ChangePrivateState
+	}
+	return ""
+}
+
+func (x *ReviewTrigger) GetChangeCommitMessage() string {
+	if x != nil {
+		return x.ChangeCommitMessage
+	}
+	return ""
+}
+
+func (x *ReviewTrigger) GetPatchsetNumber() string {
+	if x != nil {
+		return x.PatchsetNumber
+	}
+	return ""
+}
+
+func (x *ReviewTrigger) GetPatchsetRevision() string {
+	if x != nil {
+		return x.PatchsetRevision
+	}
+	return ""
+}
+
+func (x *ReviewTrigger) GetPatchsetUploader() string {
+	if x != nil {
+		return x.PatchsetUploader
+	}
+	return ""
+}
+
+func (x *ReviewTrigger) GetPatchsetUploaderName() string {
+	if x != nil {
+		return x.PatchsetUploaderName
+	}
+	return ""
+}
+
+func (x *ReviewTrigger) GetPatchsetUploaderEmail() string {
+	if x != nil {
+		return x.PatchsetUploaderEmail
+	}
+	return ""
+}
+
+type SshConfig struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host    string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`       // ssh host
+	Port    int64  `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`      // ssh port
+	User    string `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`       // ssh user
+	Pass    string `protobuf:"bytes,4,opt,name=pass,proto3" json:"pass,omitempty"`       // ssh pass
+	Key     string `protobuf:"bytes,5,opt,name=key,proto3" json:"key,omitempty"`         // ssh private key
+	Timeout string `protobuf:"bytes,6,opt,name=timeout,proto3" json:"timeout,omitempty"` // ssh timeout time in string (h:hour, m:minute, s:second)
+}
+
+func (x *SshConfig) Reset() {
+	*x = SshConfig{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SshConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SshConfig) ProtoMessage() {}
+
+func (x *SshConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SshConfig.ProtoReflect.Descriptor instead.
+func (*SshConfig) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SshConfig) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *SshConfig) GetPort() int64 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *SshConfig) GetUser() string {
+	if x != nil {
+		return x.User
+	}
+	return ""
+}
+
+func (x *SshConfig) GetPass() string {
+	if x != nil {
+		return x.Pass
+	}
+	return ""
+}
+
+func (x *SshConfig) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *SshConfig) GetTimeout() string {
+	if x != nil {
+		return x.Timeout
+	}
+	return ""
+}
+
+type TriggerResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ArtifactInfo  *ArtifactInfo  `protobuf:"bytes,1,opt,name=artifactInfo,proto3" json:"artifactInfo,omitempty"`   // artifactsight info
+	BuildInfo     *BuildInfo     `protobuf:"bytes,2,opt,name=buildInfo,proto3" json:"buildInfo,omitempty"`         // buildsight info
+	CodeInfo      *CodeInfo      `protobuf:"bytes,3,opt,name=codeInfo,proto3" json:"codeInfo,omitempty"`           // codesight info
+	MailInfo      *MailInfo      `protobuf:"bytes,4,opt,name=mailInfo,proto3" json:"mailInfo,omitempty"`           // mail info
+	NodeInfo      *NodeInfo      `protobuf:"bytes,5,opt,name=nodeInfo,proto3" json:"nodeInfo,omitempty"`           // nodesight info
+	ToolchainInfo *ToolchainInfo `protobuf:"bytes,6,opt,name=toolchainInfo,proto3" json:"toolchainInfo,omitempty"` // toolchainsight info
+}
+
+func (x *TriggerResponse) Reset() {
+	*x = TriggerResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TriggerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TriggerResponse) ProtoMessage() {}
+
+func (x *TriggerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TriggerResponse.ProtoReflect.Descriptor instead.
+func (*TriggerResponse) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *TriggerResponse) GetArtifactInfo() *ArtifactInfo {
+	if x != nil {
+		return x.ArtifactInfo
+	}
+	return nil
+}
+
+func (x *TriggerResponse) GetBuildInfo() *BuildInfo {
+	if x != nil {
+		return x.BuildInfo
+	}
+	return nil
+}
+
+func (x *TriggerResponse) GetCodeInfo() *CodeInfo {
+	if x != nil {
+		return x.CodeInfo
+	}
+	return nil
+}
+
+func (x *TriggerResponse) GetMailInfo() *MailInfo {
+	if x != nil {
+		return x.MailInfo
+	}
+	return nil
+}
+
+func (x *TriggerResponse) GetNodeInfo() *NodeInfo {
+	if x != nil {
+		return x.NodeInfo
+	}
+	return nil
+}
+
+func (x *TriggerResponse) GetToolchainInfo() *ToolchainInfo {
+	if x != nil {
+		return x.ToolchainInfo
+	}
+	return nil
+}
+
+type ArtifactInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ArtifactInfo) Reset() {
+	*x = ArtifactInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArtifactInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArtifactInfo) ProtoMessage() {}
+
+func (x *ArtifactInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArtifactInfo.ProtoReflect.Descriptor instead.
+func (*ArtifactInfo) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{28}
+}
+
+type BuildInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoggingInfos []*LoggingInfo `protobuf:"bytes,1,rep,name=loggingInfos,proto3" json:"loggingInfos,omitempty"` // logging infos
+	RepoInfos    []*RepoInfo    `protobuf:"bytes,2,rep,name=repoInfos,proto3" json:"repoInfos,omitempty"`       // repo infos (Gitiles)
+	ReviewInfos  []*ReviewInfo  `protobuf:"bytes,3,rep,name=reviewInfos,proto3" json:"reviewInfos,omitempty"`   // review infos (Gerrit, pingview)
+	Error        string         `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+	RootCause    string         `protobuf:"bytes,5,opt,name=rootCause,proto3" json:"rootCause,omitempty"`   // root cause explained by gpt
+	Suggestion   string         `protobuf:"bytes,6,opt,name=suggestion,proto3" json:"suggestion,omitempty"` // suggested fix by gpt
+}
+
+func (x *BuildInfo) Reset() {
+	*x = BuildInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BuildInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BuildInfo) ProtoMessage() {}
+
+func (x *BuildInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BuildInfo.ProtoReflect.Descriptor instead.
+func (*BuildInfo) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *BuildInfo) GetLoggingInfos() []*LoggingInfo {
+	if x != nil {
+		return x.LoggingInfos
+	}
+	return nil
+}
+
+func (x *BuildInfo) GetRepoInfos() []*RepoInfo {
+	if x != nil {
+		return x.RepoInfos
+	}
+	return nil
+}
+
+func (x *BuildInfo) GetReviewInfos() []*ReviewInfo {
+	if x != nil {
+		return x.ReviewInfos
+	}
+	return nil
+}
+
+func (x *BuildInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetRootCause() string {
+	if x != nil {
+		return x.RootCause
+	}
+	return ""
+}
+
+func (x *BuildInfo) GetSuggestion() string {
+	if x != nil {
+		return x.Suggestion
+	}
+	return ""
+}
+
+type CodeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *CodeInfo) Reset() {
+	*x = CodeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CodeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CodeInfo) ProtoMessage() {}
+
+func (x *CodeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CodeInfo.ProtoReflect.Descriptor instead.
+func (*CodeInfo) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CodeInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type MailInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContentType string   `protobuf:"bytes,1,opt,name=contentType,proto3" json:"contentType,omitempty"` // content type (e.g., "text/html" or "text/plain")
+	FromAddress string   `protobuf:"bytes,2,opt,name=fromAddress,proto3" json:"fromAddress,omitempty"` // from address (e.g., "pipeflow")
+	ToAddresses []string `protobuf:"bytes,3,rep,name=toAddresses,proto3" json:"toAddresses,omitempty"` // to addresses (e.g., "name@example.com")
+	CcAddresses []string `protobuf:"bytes,4,rep,name=ccAddresses,proto3" json:"ccAddresses,omitempty"` // cc addresses (e.g., "name@example.com")
+	Subject     string   `protobuf:"bytes,5,opt,name=subject,proto3" json:"subject,omitempty"`         // subject content (e.g., "[buildsight]: ...")
+	Body        string   `protobuf:"bytes,6,opt,name=body,proto3" json:"body,omitempty"`               // body content
+	Attachments []string `protobuf:"bytes,7,rep,name=attachments,proto3" json:"attachments,omitempty"` // attachment files (e.g., "/tmp/insight-mail-codesight-123/lint.sarif", removed by caller once mailed)
+}
+
+func (x *MailInfo) Reset() {
+	*x = MailInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MailInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MailInfo) ProtoMessage() {}
+
+func (x *MailInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MailInfo.ProtoReflect.Descriptor instead.
+func (*MailInfo) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MailInfo) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *MailInfo) GetFromAddress() string {
+	if x != nil {
+		return x.FromAddress
+	}
+	return ""
+}
+
+func (x *MailInfo) GetToAddresses() []string {
+	if x != nil {
+		return x.ToAddresses
+	}
+	return nil
+}
+
+func (x *MailInfo) GetCcAddresses() []string {
+	if x != nil {
+		return x.CcAddresses
+	}
+	return nil
+}
+
+func (x *MailInfo) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+func (x *MailInfo) GetBody() string {
+	if x != nil {
+		return x.Body
+	}
+	return ""
+}
+
+func (x *MailInfo) GetAttachments() []string {
+	if x != nil {
+		return x.Attachments
+	}
+	return nil
+}
+
+type NodeInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NodeStat   *NodeStat   `protobuf:"bytes,1,opt,name=nodeStat,proto3" json:"nodeStat,omitempty"`     // node statistic (shown on web)
+	NodeReport *NodeReport `protobuf:"bytes,2,opt,name=nodeReport,proto3" json:"nodeReport,omitempty"` // node report (empty: pass, nonempty: fail)
+	Error      string      `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *NodeInfo) Reset() {
+	*x = NodeInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeInfo) ProtoMessage() {}
+
+func (x *NodeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeInfo.ProtoReflect.Descriptor instead.
+func (*NodeInfo) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *NodeInfo) GetNodeStat() *NodeStat {
+	if x != nil {
+		return x.NodeStat
+	}
+	return nil
+}
+
+func (x *NodeInfo) GetNodeReport() *NodeReport {
+	if x != nil {
+		return x.NodeReport
+	}
+	return nil
+}
+
+func (x *NodeInfo) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type ToolchainInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ToolchainInfo) Reset() {
+	*x = ToolchainInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ToolchainInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ToolchainInfo) ProtoMessage() {}
+
+func (x *ToolchainInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ToolchainInfo.ProtoReflect.Descriptor instead.
+func (*ToolchainInfo) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{33}
+}
+
+type LoggingInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	File      string `protobuf:"bytes,1,opt,name=file,proto3" json:"file,omitempty"`            // file name
+	StartLine int64  `protobuf:"varint,2,opt,name=startLine,proto3" json:"startLine,omitempty"` // start line of region in logging
+	Type      string `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`            // error type (info, warn, error)
+	Detail    string `protobuf:"bytes,4,opt,name=detail,proto3" json:"detail,omitempty"`        // error detail (e.g., type="info" detail="Build completed successfully.")
+	EndLine   int64  `protobuf:"varint,5,opt,name=endLine,proto3" json:"endLine,omitempty"`     // end line of region in logging
+}
+
+func (x *LoggingInfo) Reset() {
+	*x = LoggingInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoggingInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoggingInfo) ProtoMessage() {}
+
+func (x *LoggingInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoggingInfo.ProtoReflect.Descriptor instead.
+func (*LoggingInfo) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *LoggingInfo) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *LoggingInfo) GetStartLine() int64 {
+	if x != nil {
+		return x.StartLine
+	}
+	return 0
+}
+
+func (x *LoggingInfo) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *LoggingInfo) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *LoggingInfo) GetEndLine() int64 {
+	if x != nil {
+		return x.EndLine
+	}
+	return 0
+}
+
+type RepoInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Project   string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"`     // project name in repo
+	Branch    string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`       // branch name in repo
+	Commit    string `protobuf:"bytes,3,opt,name=commit,proto3" json:"commit,omitempty"`       // commit id in repo
+	Committer string `protobuf:"bytes,4,opt,name=committer,proto3" json:"committer,omitempty"` // committer name in repo
+	Author    string `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`       // author name in repo
+	Message   string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`     // commit message in repo
+	Date      string `protobuf:"bytes,7,opt,name=date,proto3" json:"date,omitempty"`           // commit updated date in repo
+}
+
+func (x *RepoInfo) Reset() {
+	*x = RepoInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepoInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepoInfo) ProtoMessage() {}
+
+func (x *RepoInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepoInfo.ProtoReflect.Descriptor instead.
+func (*RepoInfo) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RepoInfo) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *RepoInfo) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *RepoInfo) GetCommit() string {
+	if x != nil {
+		return x.Commit
+	}
+	return ""
+}
+
+func (x *RepoInfo) GetCommitter() string {
+	if x != nil {
+		return x.Committer
+	}
+	return ""
+}
+
+func (x *RepoInfo) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *RepoInfo) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *RepoInfo) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type ReviewInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Project string `protobuf:"bytes,1,opt,name=project,proto3" json:"project,omitempty"` // project name in review
+	Branch  string `protobuf:"bytes,2,opt,name=branch,proto3" json:"branch,omitempty"`   // branch name in review
+	Change  int64  `protobuf:"varint,3,opt,name=change,proto3" json:"change,omitempty"`  // change id in review
+	Owner   string `protobuf:"bytes,4,opt,name=owner,proto3" json:"owner,omitempty"`     // owner name in review
+	Author  string `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`   // author name in review
+	Message string `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"` // commit message in review
+	Date    string `protobuf:"bytes,7,opt,name=date,proto3" json:"date,omitempty"`       // commit updated date in review
+}
+
+func (x *ReviewInfo) Reset() {
+	*x = ReviewInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ReviewInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewInfo) ProtoMessage() {}
+
+func (x *ReviewInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewInfo.ProtoReflect.Descriptor instead.
+func (*ReviewInfo) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ReviewInfo) GetProject() string {
+	if x != nil {
+		return x.Project
+	}
+	return ""
+}
+
+func (x *ReviewInfo) GetBranch() string {
+	if x != nil {
+		return x.Branch
+	}
+	return ""
+}
+
+func (x *ReviewInfo) GetChange() int64 {
+	if x != nil {
+		return x.Change
+	}
+	return 0
+}
+
+func (x *ReviewInfo) GetOwner() string {
+	if x != nil {
+		return x.Owner
+	}
+	return ""
+}
+
+func (x *ReviewInfo) GetAuthor() string {
+	if x != nil {
+		return x.Author
+	}
+	return ""
+}
+
+func (x *ReviewInfo) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ReviewInfo) GetDate() string {
+	if x != nil {
+		return x.Date
+	}
+	return ""
+}
+
+type NodeStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CpuStat     *CpuStat     `protobuf:"bytes,1,opt,name=cpuStat,proto3" json:"cpuStat,omitempty"`         // cpu statistic
+	DiskStat    *DiskStat    `protobuf:"bytes,2,opt,name=diskStat,proto3" json:"diskStat,omitempty"`       // dist statistic
+	DockerStat  *DockerStat  `protobuf:"bytes,3,opt,name=dockerStat,proto3" json:"dockerStat,omitempty"`   // docker statistic
+	HostStat    *HostStat    `protobuf:"bytes,4,opt,name=hostStat,proto3" json:"hostStat,omitempty"`       // host statistic
+	LoadStat    *LoadStat    `protobuf:"bytes,5,opt,name=loadStat,proto3" json:"loadStat,omitempty"`       // load statistic
+	MemStat     *MemStat     `protobuf:"bytes,6,opt,name=memStat,proto3" json:"memStat,omitempty"`         // memory statistic
+	NetStat     *NetStat     `protobuf:"bytes,7,opt,name=netStat,proto3" json:"netStat,omitempty"`         // net statistic
+	ProcessStat *ProcessStat `protobuf:"bytes,8,opt,name=processStat,proto3" json:"processStat,omitempty"` // process statistic
+}
+
+func (x *NodeStat) Reset() {
+	*x = NodeStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeStat) ProtoMessage() {}
+
+func (x *NodeStat) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeStat.ProtoReflect.Descriptor instead.
+func (*NodeStat) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *NodeStat) GetCpuStat() *CpuStat {
+	if x != nil {
+		return x.CpuStat
+	}
+	return nil
+}
+
+func (x *NodeStat) GetDiskStat() *DiskStat {
+	if x != nil {
+		return x.DiskStat
+	}
+	return nil
+}
+
+func (x *NodeStat) GetDockerStat() *DockerStat {
+	if x != nil {
+		return x.DockerStat
+	}
+	return nil
+}
+
+func (x *NodeStat) GetHostStat() *HostStat {
+	if x != nil {
+		return x.HostStat
+	}
+	return nil
+}
+
+func (x *NodeStat) GetLoadStat() *LoadStat {
+	if x != nil {
+		return x.LoadStat
+	}
+	return nil
+}
+
+func (x *NodeStat) GetMemStat() *MemStat {
+	if x != nil {
+		return x.MemStat
+	}
+	return nil
+}
+
+func (x *NodeStat) GetNetStat() *NetStat {
+	if x != nil {
+		return x.NetStat
+	}
+	return nil
+}
+
+func (x *NodeStat) GetProcessStat() *ProcessStat {
+	if x != nil {
+		return x.ProcessStat
+	}
+	return nil
+}
+
+type NodeReport struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CpuReport     string `protobuf:"bytes,1,opt,name=cpuReport,proto3" json:"cpuReport,omitempty"`         // cpu report
+	DiskReport    string `protobuf:"bytes,2,opt,name=diskReport,proto3" json:"diskReport,omitempty"`       // disk report
+	DockerReport  string `protobuf:"bytes,3,opt,name=dockerReport,proto3" json:"dockerReport,omitempty"`   // docker report
+	HealthReport  string `protobuf:"bytes,4,opt,name=healthReport,proto3" json:"healthReport,omitempty"`   // health report
+	HostReport    string `protobuf:"bytes,5,opt,name=hostReport,proto3" json:"hostReport,omitempty"`       // host report
+	LoadReport    string `protobuf:"bytes,6,opt,name=loadReport,proto3" json:"loadReport,omitempty"`       // load report
+	MemReport     string `protobuf:"bytes,7,opt,name=memReport,proto3" json:"memReport,omitempty"`         // memory report
+	NetReport     string `protobuf:"bytes,8,opt,name=netReport,proto3" json:"netReport,omitempty"`         // net report
+	ProcessReport string `protobuf:"bytes,9,opt,name=processReport,proto3" json:"processReport,omitempty"` // process report
+}
+
+func (x *NodeReport) Reset() {
+	*x = NodeReport{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NodeReport) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NodeReport) ProtoMessage() {}
+
+func (x *NodeReport) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NodeReport.ProtoReflect.Descriptor instead.
+func (*NodeReport) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *NodeReport) GetCpuReport() string {
+	if x != nil {
+		return x.CpuReport
+	}
+	return ""
+}
+
+func (x *NodeReport) GetDiskReport() string {
+	if x != nil {
+		return x.DiskReport
+	}
+	return ""
+}
+
+func (x *NodeReport) GetDockerReport() string {
+	if x != nil {
+		return x.DockerReport
+	}
+	return ""
+}
+
+func (x *NodeReport) GetHealthReport() string {
+	if x != nil {
+		return x.HealthReport
+	}
+	return ""
+}
+
+func (x *NodeReport) GetHostReport() string {
+	if x != nil {
+		return x.HostReport
+	}
+	return ""
+}
+
+func (x *NodeReport) GetLoadReport() string {
+	if x != nil {
+		return x.LoadReport
+	}
+	return ""
+}
+
+func (x *NodeReport) GetMemReport() string {
+	if x != nil {
+		return x.MemReport
+	}
+	return ""
+}
+
+func (x *NodeReport) GetNetReport() string {
+	if x != nil {
+		return x.NetReport
+	}
+	return ""
+}
+
+func (x *NodeReport) GetProcessReport() string {
+	if x != nil {
+		return x.ProcessReport
+	}
+	return ""
+}
+
+type CpuStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PhysicalCount int64      `protobuf:"varint,1,opt,name=physicalCount,proto3" json:"physicalCount,omitempty"` // physical cores
+	LogicalCount  int64      `protobuf:"varint,2,opt,name=logicalCount,proto3" json:"logicalCount,omitempty"`   // logical cores
+	CpuTimes      []*CpuTime `protobuf:"bytes,3,rep,name=cpuTimes,proto3" json:"cpuTimes,omitempty"`            // the time of cpu used per cpu in list
+}
+
+func (x *CpuStat) Reset() {
+	*x = CpuStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CpuStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CpuStat) ProtoMessage() {}
+
+func (x *CpuStat) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CpuStat.ProtoReflect.Descriptor instead.
+func (*CpuStat) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CpuStat) GetPhysicalCount() int64 {
+	if x != nil {
+		return x.PhysicalCount
+	}
+	return 0
+}
+
+func (x *CpuStat) GetLogicalCount() int64 {
+	if x != nil {
+		return x.LogicalCount
+	}
+	return 0
+}
+
+func (x *CpuStat) GetCpuTimes() []*CpuTime {
+	if x != nil {
+		return x.CpuTimes
+	}
+	return nil
+}
+
+type DiskStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DiskPartitions []*DiskPartition `protobuf:"bytes,1,rep,name=diskPartitions,proto3" json:"diskPartitions,omitempty"` // disk partitions in list (for physical devices only)
+	DiskUsage      *DiskUsage       `protobuf:"bytes,2,opt,name=diskUsage,proto3" json:"diskUsage,omitempty"`           // file system usage
+}
+
+func (x *DiskStat) Reset() {
+	*x = DiskStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskStat) ProtoMessage() {}
+
+func (x *DiskStat) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskStat.ProtoReflect.Descriptor instead.
+func (*DiskStat) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *DiskStat) GetDiskPartitions() []*DiskPartition {
+	if x != nil {
+		return x.DiskPartitions
+	}
+	return nil
+}
+
+func (x *DiskStat) GetDiskUsage() *DiskUsage {
+	if x != nil {
+		return x.DiskUsage
+	}
+	return nil
+}
+
+type DockerStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CgroupCpuDockerUsages []float64           `protobuf:"fixed64,1,rep,packed,name=cgroupCpuDockerUsages,proto3" json:"cgroupCpuDockerUsages,omitempty"` // cpu usage for docker in list
+	CgroupDockerStats     []*CgroupDockerStat `protobuf:"bytes,2,rep,name=cgroupDockerStats,proto3" json:"cgroupDockerStats,omitempty"`                  // cgroup docker stat in list
+	CgroupMemDockers      []*CgroupMemDocker  `protobuf:"bytes,3,rep,name=cgroupMemDockers,proto3" json:"cgroupMemDockers,omitempty"`                    // cgroup memory stat in list
+}
+
+func (x *DockerStat) Reset() {
+	*x = DockerStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DockerStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DockerStat) ProtoMessage() {}
+
+func (x *DockerStat) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DockerStat.ProtoReflect.Descriptor instead.
+func (*DockerStat) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *DockerStat) GetCgroupCpuDockerUsages() []float64 {
+	if x != nil {
+		return x.CgroupCpuDockerUsages
+	}
+	return nil
+}
+
+func (x *DockerStat) GetCgroupDockerStats() []*CgroupDockerStat {
+	if x != nil {
+		return x.CgroupDockerStats
+	}
+	return nil
+}
+
+func (x *DockerStat) GetCgroupMemDockers() []*CgroupMemDocker {
+	if x != nil {
+		return x.CgroupMemDockers
+	}
+	return nil
+}
+
+type HostStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hostname        string `protobuf:"bytes,1,opt,name=hostname,proto3" json:"hostname,omitempty"`               // host name
+	Procs           uint64 `protobuf:"varint,2,opt,name=procs,proto3" json:"procs,omitempty"`                    // number of processes
+	Os              string `protobuf:"bytes,3,opt,name=os,proto3" json:"os,omitempty"`                           // OS name (linux)
+	Platform        string `protobuf:"bytes,4,opt,name=platform,proto3" json:"platform,omitempty"`               // platform name (ubuntu)
+	PlatformFamily  string `protobuf:"bytes,5,opt,name=platformFamily,proto3" json:"platformFamily,omitempty"`   // platform family (debian)
+	PlatformVersion string `protobuf:"bytes,6,opt,name=platformVersion,proto3" json:"platformVersion,omitempty"` // the complete OS version
+	KernelVersion   string `protobuf:"bytes,7,opt,name=kernelVersion,proto3" json:"kernelVersion,omitempty"`     // the kernel version
+	KernelArch      string `protobuf:"bytes,8,opt,name=kernelArch,proto3" json:"kernelArch,omitempty"`           // native cpu architecture (`uname -r`)
+	HostID          string `protobuf:"bytes,9,opt,name=hostID,proto3" json:"hostID,omitempty"`                   // host id (uuid)
+}
+
+func (x *HostStat) Reset() {
+	*x = HostStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HostStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostStat) ProtoMessage() {}
+
+func (x *HostStat) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostStat.ProtoReflect.Descriptor instead.
+func (*HostStat) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *HostStat) GetHostname() string {
+	if x != nil {
+		return x.Hostname
+	}
+	return ""
+}
+
+func (x *HostStat) GetProcs() uint64 {
+	if x != nil {
+		return x.Procs
+	}
+	return 0
+}
+
+func (x *HostStat) GetOs() string {
+	if x != nil {
+		return x.Os
+	}
+	return ""
+}
+
+func (x *HostStat) GetPlatform() string {
+	if x != nil {
+		return x.Platform
+	}
+	return ""
+}
+
+func (x *HostStat) GetPlatformFamily() string {
+	if x != nil {
+		return x.PlatformFamily
+	}
+	return ""
+}
+
+func (x *HostStat) GetPlatformVersion() string {
+	if x != nil {
+		return x.PlatformVersion
+	}
+	return ""
+}
+
+func (x *HostStat) GetKernelVersion() string {
+	if x != nil {
+		return x.KernelVersion
+	}
+	return ""
+}
+
+func (x *HostStat) GetKernelArch() string {
+	if x != nil {
+		return x.KernelArch
+	}
+	return ""
+}
+
+func (x *HostStat) GetHostID() string {
+	if x != nil {
+		return x.HostID
+	}
+	return ""
+}
+
+type LoadStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LoadAvg  *LoadAvg  `protobuf:"bytes,1,opt,name=loadAvg,proto3" json:"loadAvg,omitempty"`   // load average
+	LoadMisc *LoadMisc `protobuf:"bytes,2,opt,name=loadMisc,proto3" json:"loadMisc,omitempty"` // load misc
+}
+
+func (x *LoadStat) Reset() {
+	*x = LoadStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadStat) ProtoMessage() {}
+
+func (x *LoadStat) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadStat.ProtoReflect.Descriptor instead.
+func (*LoadStat) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *LoadStat) GetLoadAvg() *LoadAvg {
+	if x != nil {
+		return x.LoadAvg
+	}
+	return nil
+}
+
+func (x *LoadStat) GetLoadMisc() *LoadMisc {
+	if x != nil {
+		return x.LoadMisc
+	}
+	return nil
+}
+
+type MemStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MemSwapDevices []*MemSwapDevice `protobuf:"bytes,1,rep,name=memSwapDevices,proto3" json:"memSwapDevices,omitempty"` // swap device in list
+	MemSwapMemory  *MemSwapMemory   `protobuf:"bytes,2,opt,name=memSwapMemory,proto3" json:"memSwapMemory,omitempty"`   // swap memory
+	MemVirtual     *MemVirtual      `protobuf:"bytes,3,opt,name=memVirtual,proto3" json:"memVirtual,omitempty"`         // virtual memory
+}
+
+func (x *MemStat) Reset() {
+	*x = MemStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemStat) ProtoMessage() {}
+
+func (x *MemStat) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemStat.ProtoReflect.Descriptor instead.
+func (*MemStat) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *MemStat) GetMemSwapDevices() []*MemSwapDevice {
+	if x != nil {
+		return x.MemSwapDevices
+	}
+	return nil
+}
+
+func (x *MemStat) GetMemSwapMemory() *MemSwapMemory {
+	if x != nil {
+		return x.MemSwapMemory
+	}
+	return nil
+}
+
+func (x *MemStat) GetMemVirtual() *MemVirtual {
+	if x != nil {
+		return x.MemVirtual
+	}
+	return nil
+}
+
+type NetStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	NetIos        []*NetIo        `protobuf:"bytes,1,rep,name=netIos,proto3" json:"netIos,omitempty"`               // network I/O statistics in list
+	NetInterfaces []*NetInterface `protobuf:"bytes,2,rep,name=netInterfaces,proto3" json:"netInterfaces,omitempty"` // network interface in list
+}
+
+func (x *NetStat) Reset() {
+	*x = NetStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetStat) ProtoMessage() {}
+
+func (x *NetStat) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetStat.ProtoReflect.Descriptor instead.
+func (*NetStat) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *NetStat) GetNetIos() []*NetIo {
+	if x != nil {
+		return x.NetIos
+	}
+	return nil
+}
+
+func (x *NetStat) GetNetInterfaces() []*NetInterface {
+	if x != nil {
+		return x.NetInterfaces
+	}
+	return nil
+}
+
+type ProcessStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProcessInfos []*ProcessInfo `protobuf:"bytes,1,rep,name=processInfos,proto3" json:"processInfos,omitempty"` // process info in list
+}
+
+func (x *ProcessStat) Reset() {
+	*x = ProcessStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessStat) ProtoMessage() {}
+
+func (x *ProcessStat) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessStat.ProtoReflect.Descriptor instead.
+func (*ProcessStat) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ProcessStat) GetProcessInfos() []*ProcessInfo {
+	if x != nil {
+		return x.ProcessInfos
+	}
+	return nil
+}
+
+type CpuTime struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cpu       string  `protobuf:"bytes,1,opt,name=cpu,proto3" json:"cpu,omitempty"`
+	User      float64 `protobuf:"fixed64,2,opt,name=user,proto3" json:"user,omitempty"`
+	System    float64 `protobuf:"fixed64,3,opt,name=system,proto3" json:"system,omitempty"`
+	Idle      float64 `protobuf:"fixed64,4,opt,name=idle,proto3" json:"idle,omitempty"`
+	Nice      float64 `protobuf:"fixed64,5,opt,name=nice,proto3" json:"nice,omitempty"`
+	Iowait    float64 `protobuf:"fixed64,6,opt,name=iowait,proto3" json:"iowait,omitempty"`
+	Irq       float64 `protobuf:"fixed64,7,opt,name=irq,proto3" json:"irq,omitempty"`
+	Softirq   float64 `protobuf:"fixed64,8,opt,name=softirq,proto3" json:"softirq,omitempty"`
+	Steal     float64 `protobuf:"fixed64,9,opt,name=steal,proto3" json:"steal,omitempty"`
+	Guest     float64 `protobuf:"fixed64,10,opt,name=guest,proto3" json:"guest,omitempty"`
+	GuestNice float64 `protobuf:"fixed64,11,opt,name=guestNice,proto3" json:"guestNice,omitempty"`
+}
+
+func (x *CpuTime) Reset() {
+	*x = CpuTime{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CpuTime) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CpuTime) ProtoMessage() {}
+
+func (x *CpuTime) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CpuTime.ProtoReflect.Descriptor instead.
+func (*CpuTime) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CpuTime) GetCpu() string {
+	if x != nil {
+		return x.Cpu
+	}
+	return ""
+}
+
+func (x *CpuTime) GetUser() float64 {
+	if x != nil {
+		return x.User
+	}
+	return 0
+}
+
+func (x *CpuTime) GetSystem() float64 {
+	if x != nil {
+		return x.System
+	}
+	return 0
+}
+
+func (x *CpuTime) GetIdle() float64 {
+	if x != nil {
+		return x.Idle
+	}
+	return 0
+}
+
+func (x *CpuTime) GetNice() float64 {
+	if x != nil {
+		return x.Nice
+	}
+	return 0
+}
+
+func (x *CpuTime) GetIowait() float64 {
+	if x != nil {
+		return x.Iowait
+	}
+	return 0
+}
+
+func (x *CpuTime) GetIrq() float64 {
+	if x != nil {
+		return x.Irq
+	}
+	return 0
+}
+
+func (x *CpuTime) GetSoftirq() float64 {
+	if x != nil {
+		return x.Softirq
+	}
+	return 0
+}
+
+func (x *CpuTime) GetSteal() float64 {
+	if x != nil {
+		return x.Steal
+	}
+	return 0
+}
+
+func (x *CpuTime) GetGuest() float64 {
+	if x != nil {
+		return x.Guest
+	}
+	return 0
+}
+
+func (x *CpuTime) GetGuestNice() float64 {
+	if x != nil {
+		return x.GuestNice
+	}
+	return 0
+}
+
+type DiskPartition struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Device     string   `protobuf:"bytes,1,opt,name=device,proto3" json:"device,omitempty"`
+	Mountpoint string   `protobuf:"bytes,2,opt,name=mountpoint,proto3" json:"mountpoint,omitempty"`
+	Fstype     string   `protobuf:"bytes,3,opt,name=fstype,proto3" json:"fstype,omitempty"`
+	Opts       []string `protobuf:"bytes,4,rep,name=opts,proto3" json:"opts,omitempty"`
+}
+
+func (x *DiskPartition) Reset() {
+	*x = DiskPartition{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskPartition) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskPartition) ProtoMessage() {}
+
+func (x *DiskPartition) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskPartition.ProtoReflect.Descriptor instead.
+func (*DiskPartition) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *DiskPartition) GetDevice() string {
+	if x != nil {
+		return x.Device
+	}
+	return ""
+}
+
+func (x *DiskPartition) GetMountpoint() string {
+	if x != nil {
+		return x.Mountpoint
+	}
+	return ""
+}
+
+func (x *DiskPartition) GetFstype() string {
+	if x != nil {
+		return x.Fstype
+	}
+	return ""
+}
+
+func (x *DiskPartition) GetOpts() []string {
+	if x != nil {
+		return x.Opts
+	}
+	return nil
+}
+
+type DiskUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path        string  `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Fstype      string  `protobuf:"bytes,2,opt,name=fstype,proto3" json:"fstype,omitempty"`
+	Total       uint64  `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	Free        uint64  `protobuf:"varint,4,opt,name=free,proto3" json:"free,omitempty"`
+	Used        uint64  `protobuf:"varint,5,opt,name=used,proto3" json:"used,omitempty"`
+	UsedPercent float64 `protobuf:"fixed64,6,opt,name=usedPercent,proto3" json:"usedPercent,omitempty"`
+}
+
+func (x *DiskUsage) Reset() {
+	*x = DiskUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DiskUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiskUsage) ProtoMessage() {}
+
+func (x *DiskUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiskUsage.ProtoReflect.Descriptor instead.
+func (*DiskUsage) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *DiskUsage) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *DiskUsage) GetFstype() string {
+	if x != nil {
+		return x.Fstype
+	}
+	return ""
+}
+
+func (x *DiskUsage) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetFree() uint64 {
+	if x != nil {
+		return x.Free
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetUsed() uint64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *DiskUsage) GetUsedPercent() float64 {
+	if x != nil {
+		return x.UsedPercent
+	}
+	return 0
+}
+
+type CgroupDockerStat struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ContainerId string `protobuf:"bytes,1,opt,name=containerId,proto3" json:"containerId,omitempty"`
+	Name        string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Image       string `protobuf:"bytes,3,opt,name=image,proto3" json:"image,omitempty"`
+	Status      string `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Running     bool   `protobuf:"varint,5,opt,name=running,proto3" json:"running,omitempty"`
+}
+
+func (x *CgroupDockerStat) Reset() {
+	*x = CgroupDockerStat{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CgroupDockerStat) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CgroupDockerStat) ProtoMessage() {}
+
+func (x *CgroupDockerStat) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CgroupDockerStat.ProtoReflect.Descriptor instead.
+func (*CgroupDockerStat) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CgroupDockerStat) GetContainerId() string {
+	if x != nil {
+		return x.ContainerId
+	}
+	return ""
+}
+
+func (x *CgroupDockerStat) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CgroupDockerStat) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *CgroupDockerStat) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CgroupDockerStat) GetRunning() bool {
+	if x != nil {
+		return x.Running
+	}
+	return false
+}
+
+type CgroupMemDocker struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Cache              uint64 `protobuf:"varint,1,opt,name=cache,proto3" json:"cache,omitempty"`
+	Rss                uint64 `protobuf:"varint,2,opt,name=rss,proto3" json:"rss,omitempty"`
+	RssHuge            uint64 `protobuf:"varint,3,opt,name=rssHuge,proto3" json:"rssHuge,omitempty"`
+	MappedFile         uint64 `protobuf:"varint,4,opt,name=mappedFile,proto3" json:"mappedFile,omitempty"`
+	TotalCache         uint64 `protobuf:"varint,5,opt,name=totalCache,proto3" json:"totalCache,omitempty"`
+	TotalRss           uint64 `protobuf:"varint,6,opt,name=totalRss,proto3" json:"totalRss,omitempty"`
+	TotalRssHuge       uint64 `protobuf:"varint,7,opt,name=totalRssHuge,proto3" json:"totalRssHuge,omitempty"`
+	TotalMappedFile    uint64 `protobuf:"varint,8,opt,name=totalMappedFile,proto3" json:"totalMappedFile,omitempty"`
+	MemUsageInBytes    uint64 `protobuf:"varint,9,opt,name=memUsageInBytes,proto3" json:"memUsageInBytes,omitempty"`
+	MemMaxUsageInBytes uint64 `protobuf:"varint,10,opt,name=memMaxUsageInBytes,proto3" json:"memMaxUsageInBytes,omitempty"`
+	MemLimitInBytes    uint64 `protobuf:"varint,11,opt,name=memLimitInBytes,proto3" json:"memLimitInBytes,omitempty"`
+}
+
+func (x *CgroupMemDocker) Reset() {
+	*x = CgroupMemDocker{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CgroupMemDocker) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CgroupMemDocker) ProtoMessage() {}
+
+func (x *CgroupMemDocker) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CgroupMemDocker.ProtoReflect.Descriptor instead.
+func (*CgroupMemDocker) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *CgroupMemDocker) GetCache() uint64 {
+	if x != nil {
+		return x.Cache
+	}
+	return 0
+}
+
+func (x *CgroupMemDocker) GetRss() uint64 {
+	if x != nil {
+		return x.Rss
+	}
+	return 0
+}
+
+func (x *CgroupMemDocker) GetRssHuge() uint64 {
+	if x != nil {
+		return x.RssHuge
+	}
+	return 0
+}
+
+func (x *CgroupMemDocker) GetMappedFile() uint64 {
+	if x != nil {
+		return x.MappedFile
+	}
+	return 0
+}
+
+func (x *CgroupMemDocker) GetTotalCache() uint64 {
+	if x != nil {
+		return x.TotalCache
+	}
+	return 0
+}
+
+func (x *CgroupMemDocker) GetTotalRss() uint64 {
+	if x != nil {
+		return x.TotalRss
+	}
+	return 0
+}
+
+func (x *CgroupMemDocker) GetTotalRssHuge() uint64 {
+	if x != nil {
+		return x.TotalRssHuge
+	}
+	return 0
+}
+
+func (x *CgroupMemDocker) GetTotalMappedFile() uint64 {
+	if x != nil {
+		return x.TotalMappedFile
+	}
+	return 0
+}
+
+func (x *CgroupMemDocker) GetMemUsageInBytes() uint64 {
+	if x != nil {
+		return x.MemUsageInBytes
+	}
+	return 0
+}
+
+func (x *CgroupMemDocker) GetMemMaxUsageInBytes() uint64 {
+	if x != nil {
+		return x.MemMaxUsageInBytes
+	}
+	return 0
+}
+
+func (x *CgroupMemDocker) GetMemLimitInBytes() uint64 {
+	if x != nil {
+		return x.MemLimitInBytes
+	}
+	return 0
+}
+
+type LoadAvg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Load1  float64 `protobuf:"fixed64,1,opt,name=load1,proto3" json:"load1,omitempty"`
+	Load5  float64 `protobuf:"fixed64,2,opt,name=load5,proto3" json:"load5,omitempty"`
+	Load15 float64 `protobuf:"fixed64,3,opt,name=load15,proto3" json:"load15,omitempty"`
+}
+
+func (x *LoadAvg) Reset() {
+	*x = LoadAvg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadAvg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadAvg) ProtoMessage() {}
+
+func (x *LoadAvg) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadAvg.ProtoReflect.Descriptor instead.
+func (*LoadAvg) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *LoadAvg) GetLoad1() float64 {
+	if x != nil {
+		return x.Load1
+	}
+	return 0
+}
+
+func (x *LoadAvg) GetLoad5() float64 {
+	if x != nil {
+		return x.Load5
+	}
+	return 0
+}
+
+func (x *LoadAvg) GetLoad15() float64 {
+	if x != nil {
+		return x.Load15
+	}
+	return 0
+}
+
+type LoadMisc struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ProcsTotal   int64 `protobuf:"varint,1,opt,name=procsTotal,proto3" json:"procsTotal,omitempty"`
+	ProcsCreated int64 `protobuf:"varint,2,opt,name=procsCreated,proto3" json:"procsCreated,omitempty"`
+	ProcsRunning int64 `protobuf:"varint,3,opt,name=procsRunning,proto3" json:"procsRunning,omitempty"`
+	ProcsBlocked int64 `protobuf:"varint,4,opt,name=procsBlocked,proto3" json:"procsBlocked,omitempty"`
+	Ctxt         int64 `protobuf:"varint,5,opt,name=ctxt,proto3" json:"ctxt,omitempty"`
+}
+
+func (x *LoadMisc) Reset() {
+	*x = LoadMisc{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LoadMisc) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoadMisc) ProtoMessage() {}
+
+func (x *LoadMisc) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoadMisc.ProtoReflect.Descriptor instead.
+func (*LoadMisc) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *LoadMisc) GetProcsTotal() int64 {
+	if x != nil {
+		return x.ProcsTotal
+	}
+	return 0
+}
+
+func (x *LoadMisc) GetProcsCreated() int64 {
+	if x != nil {
+		return x.ProcsCreated
+	}
+	return 0
+}
+
+func (x *LoadMisc) GetProcsRunning() int64 {
+	if x != nil {
+		return x.ProcsRunning
+	}
+	return 0
+}
+
+func (x *LoadMisc) GetProcsBlocked() int64 {
+	if x != nil {
+		return x.ProcsBlocked
+	}
+	return 0
+}
+
+func (x *LoadMisc) GetCtxt() int64 {
+	if x != nil {
+		return x.Ctxt
+	}
+	return 0
+}
+
+type MemSwapDevice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name      string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	UsedBytes uint64 `protobuf:"varint,2,opt,name=usedBytes,proto3" json:"usedBytes,omitempty"`
+	FreeBytes uint64 `protobuf:"varint,3,opt,name=freeBytes,proto3" json:"freeBytes,omitempty"`
+}
+
+func (x *MemSwapDevice) Reset() {
+	*x = MemSwapDevice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemSwapDevice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemSwapDevice) ProtoMessage() {}
+
+func (x *MemSwapDevice) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemSwapDevice.ProtoReflect.Descriptor instead.
+func (*MemSwapDevice) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *MemSwapDevice) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemSwapDevice) GetUsedBytes() uint64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+func (x *MemSwapDevice) GetFreeBytes() uint64 {
+	if x != nil {
+		return x.FreeBytes
+	}
+	return 0
+}
+
+type MemSwapMemory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total       uint64  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Used        uint64  `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+	Free        uint64  `protobuf:"varint,3,opt,name=free,proto3" json:"free,omitempty"`
+	UsedPercent float64 `protobuf:"fixed64,4,opt,name=usedPercent,proto3" json:"usedPercent,omitempty"`
+}
+
+func (x *MemSwapMemory) Reset() {
+	*x = MemSwapMemory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemSwapMemory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemSwapMemory) ProtoMessage() {}
+
+func (x *MemSwapMemory) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemSwapMemory.ProtoReflect.Descriptor instead.
+func (*MemSwapMemory) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *MemSwapMemory) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *MemSwapMemory) GetUsed() uint64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *MemSwapMemory) GetFree() uint64 {
+	if x != nil {
+		return x.Free
+	}
+	return 0
+}
+
+func (x *MemSwapMemory) GetUsedPercent() float64 {
+	if x != nil {
+		return x.UsedPercent
+	}
+	return 0
+}
+
+type MemVirtual struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Total          uint64  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Available      uint64  `protobuf:"varint,2,opt,name=available,proto3" json:"available,omitempty"`
+	Used           uint64  `protobuf:"varint,3,opt,name=used,proto3" json:"used,omitempty"`
+	UsedPercent    float64 `protobuf:"fixed64,4,opt,name=usedPercent,proto3" json:"usedPercent,omitempty"`
+	Free           uint64  `protobuf:"varint,5,opt,name=free,proto3" json:"free,omitempty"`
+	Buffer         uint64  `protobuf:"varint,6,opt,name=buffer,proto3" json:"buffer,omitempty"`
+	Cached         uint64  `protobuf:"varint,7,opt,name=cached,proto3" json:"cached,omitempty"`
+	SwapCached     uint64  `protobuf:"varint,8,opt,name=swapCached,proto3" json:"swapCached,omitempty"`
+	SwapTotal      uint64  `protobuf:"varint,9,opt,name=swapTotal,proto3" json:"swapTotal,omitempty"`
+	SwapFree       uint64  `protobuf:"varint,10,opt,name=swapFree,proto3" json:"swapFree,omitempty"`
+	Mapped         uint64  `protobuf:"varint,11,opt,name=mapped,proto3" json:"mapped,omitempty"`
+	VmallocTotal   uint64  `protobuf:"varint,12,opt,name=vmallocTotal,proto3" json:"vmallocTotal,omitempty"`
+	VmallocUsed    uint64  `protobuf:"varint,13,opt,name=vmallocUsed,proto3" json:"vmallocUsed,omitempty"`
+	VmallocChunk   uint64  `protobuf:"varint,14,opt,name=vmallocChunk,proto3" json:"vmallocChunk,omitempty"`
+	HugePagesTotal uint64  `protobuf:"varint,15,opt,name=hugePagesTotal,proto3" json:"hugePagesTotal,omitempty"`
+	HugePagesFree  uint64  `protobuf:"varint,16,opt,name=hugePagesFree,proto3" json:"hugePagesFree,omitempty"`
+	HugePagesRsvd  uint64  `protobuf:"varint,17,opt,name=hugePagesRsvd,proto3" json:"hugePagesRsvd,omitempty"`
+	HugePagesSurp  uint64  `protobuf:"varint,18,opt,name=hugePagesSurp,proto3" json:"hugePagesSurp,omitempty"`
+	HugePageSize   uint64  `protobuf:"varint,19,opt,name=hugePageSize,proto3" json:"hugePageSize,omitempty"`
+	AnonHugePage   uint64  `protobuf:"varint,20,opt,name=anonHugePage,proto3" json:"anonHugePage,omitempty"`
+}
+
+func (x *MemVirtual) Reset() {
+	*x = MemVirtual{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MemVirtual) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemVirtual) ProtoMessage() {}
+
+func (x *MemVirtual) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemVirtual.ProtoReflect.Descriptor instead.
+func (*MemVirtual) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *MemVirtual) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *MemVirtual) GetAvailable() uint64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *MemVirtual) GetUsed() uint64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *MemVirtual) GetUsedPercent() float64 {
+	if x != nil {
+		return x.UsedPercent
+	}
+	return 0
+}
+
+func (x *MemVirtual) GetFree() uint64 {
+	if x != nil {
+		return x.Free
+	}
+	return 0
+}
+
+func (x *MemVirtual) GetBuffer() uint64 {
+	if x != nil {
+		return x.Buffer
+	}
+	return 0
+}
+
+func (x *MemVirtual) GetCached() uint64 {
+	if x != nil {
+		return x.Cached
+	}
+	return 0
+}
+
+func (x *MemVirtual) GetSwapCached() uint64 {
+	if x != nil {
+		return x.SwapCached
+	}
+	return 0
+}
+
+func (x *MemVirtual) GetSwapTotal() uint64 {
+	if x != nil {
+		return x.SwapTotal
+	}
+	return 0
+}
+
+func (x *MemVirtual) GetSwapFree() uint64 {
+	if x != nil {
+		return x.SwapFree
+	}
+	return 0
+}
+
+func (x *MemVirtual) GetMapped() uint64 {
+	if x != nil {
+		return x.Mapped
+	}
+	return 0
+}
+
+func (x *MemVirtual) GetVmallocTotal() uint64 {
+	if x != nil {
+		return x.VmallocTotal
+	}
+	return 0
+}
+
+func (x *MemVirtual) GetVmallocUsed() uint64 {
+	if x != nil {
+		return x.VmallocUsed
+	}
+	return 0
+}
+
+func (x *MemVirtual) GetVmallocChunk() uint64 {
+	if x != nil {
+		return x.VmallocChunk
+	}
+	return 0
+}
+
+func (x *MemVirtual) GetHugePagesTotal() uint64 {
+	if x != nil {
+		return x.HugePagesTotal
+	}
+	return 0
+}
+
+func (x *MemVirtual) GetHugePagesFree() uint64 {
+	if x != nil {
+		return x.HugePagesFree
+	}
+	return 0
+}
+
+func (x *MemVirtual) GetHugePagesRsvd() uint64 {
+	if x != nil {
+		return x.HugePagesRsvd
+	}
+	return 0
+}
+
+func (x *MemVirtual) GetHugePagesSurp() uint64 {
+	if x != nil {
+		return x.HugePagesSurp
+	}
+	return 0
+}
+
+func (x *MemVirtual) GetHugePageSize() uint64 {
+	if x != nil {
+		return x.HugePageSize
+	}
+	return 0
+}
+
+func (x *MemVirtual) GetAnonHugePage() uint64 {
+	if x != nil {
+		return x.AnonHugePage
+	}
+	return 0
+}
+
+type NetIo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	BytesSent   uint64 `protobuf:"varint,2,opt,name=bytesSent,proto3" json:"bytesSent,omitempty"`
+	BytesRecv   uint64 `protobuf:"varint,3,opt,name=bytesRecv,proto3" json:"bytesRecv,omitempty"`
+	PacketsSent uint64 `protobuf:"varint,4,opt,name=packetsSent,proto3" json:"packetsSent,omitempty"`
+	PacketsRecv uint64 `protobuf:"varint,5,opt,name=packetsRecv,proto3" json:"packetsRecv,omitempty"`
+}
+
+func (x *NetIo) Reset() {
+	*x = NetIo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetIo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetIo) ProtoMessage() {}
+
+func (x *NetIo) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetIo.ProtoReflect.Descriptor instead.
+func (*NetIo) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *NetIo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NetIo) GetBytesSent() uint64 {
+	if x != nil {
+		return x.BytesSent
+	}
+	return 0
+}
+
+func (x *NetIo) GetBytesRecv() uint64 {
+	if x != nil {
+		return x.BytesRecv
+	}
+	return 0
+}
+
+func (x *NetIo) GetPacketsSent() uint64 {
+	if x != nil {
+		return x.PacketsSent
+	}
+	return 0
+}
+
+func (x *NetIo) GetPacketsRecv() uint64 {
+	if x != nil {
+		return x.PacketsRecv
+	}
+	return 0
+}
+
+type NetInterface struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Index        int64    `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Mtu          int64    `protobuf:"varint,2,opt,name=mtu,proto3" json:"mtu,omitempty"`
+	Name         string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	HardwareAddr string   `protobuf:"bytes,4,opt,name=hardwareAddr,proto3" json:"hardwareAddr,omitempty"`
+	Flags        []string `protobuf:"bytes,5,rep,name=flags,proto3" json:"flags,omitempty"`
+	Addrs        []string `protobuf:"bytes,6,rep,name=addrs,proto3" json:"addrs,omitempty"`
+}
+
+func (x *NetInterface) Reset() {
+	*x = NetInterface{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NetInterface) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NetInterface) ProtoMessage() {}
+
+func (x *NetInterface) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NetInterface.ProtoReflect.Descriptor instead.
+func (*NetInterface) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *NetInterface) GetIndex() int64 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *NetInterface) GetMtu() int64 {
+	if x != nil {
+		return x.Mtu
+	}
+	return 0
+}
+
+func (x *NetInterface) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *NetInterface) GetHardwareAddr() string {
+	if x != nil {
+		return x.HardwareAddr
+	}
+	return ""
+}
+
+func (x *NetInterface) GetFlags() []string {
+	if x != nil {
+		return x.Flags
+	}
+	return nil
+}
+
+func (x *NetInterface) GetAddrs() []string {
+	if x != nil {
+		return x.Addrs
+	}
+	return nil
+}
+
+type ProcessInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Background        bool               `protobuf:"varint,1,opt,name=background,proto3" json:"background,omitempty"`
+	CpuPercent        float64            `protobuf:"fixed64,2,opt,name=cpuPercent,proto3" json:"cpuPercent,omitempty"`
+	Children          []int32            `protobuf:"varint,3,rep,packed,name=children,proto3" json:"children,omitempty"`
+	Cmdline           string             `protobuf:"bytes,4,opt,name=cmdline,proto3" json:"cmdline,omitempty"`
+	Environs          []string           `protobuf:"bytes,5,rep,name=environs,proto3" json:"environs,omitempty"`
+	Ionice            int32              `protobuf:"varint,6,opt,name=ionice,proto3" json:"ionice,omitempty"`
+	IsRunning         bool               `protobuf:"varint,7,opt,name=isRunning,proto3" json:"isRunning,omitempty"`
+	ProcessMemoryInfo *ProcessMemoryInfo `protobuf:"bytes,8,opt,name=processMemoryInfo,proto3" json:"processMemoryInfo,omitempty"`
+	MemoryPercent     float32            `protobuf:"fixed32,9,opt,name=memoryPercent,proto3" json:"memoryPercent,omitempty"`
+	Name              string             `protobuf:"bytes,10,opt,name=name,proto3" json:"name,omitempty"`
+	NumFd             int32              `protobuf:"varint,11,opt,name=numFd,proto3" json:"numFd,omitempty"`
+	NumThread         int32              `protobuf:"varint,12,opt,name=numThread,proto3" json:"numThread,omitempty"`
+	Parent            int32              `protobuf:"varint,13,opt,name=parent,proto3" json:"parent,omitempty"`
+	Ppid              int32              `protobuf:"varint,14,opt,name=ppid,proto3" json:"ppid,omitempty"`
+	ProcessRlimits    []*ProcessRlimit   `protobuf:"bytes,15,rep,name=processRlimits,json=processRlimit,proto3" json:"processRlimits,omitempty"`
+	Statuses          []string           `protobuf:"bytes,16,rep,name=statuses,json=statuss,proto3" json:"statuses,omitempty"`
+	Uids              []int32            `protobuf:"varint,17,rep,packed,name=uids,proto3" json:"uids,omitempty"`
+	Username          string             `protobuf:"bytes,18,opt,name=username,proto3" json:"username,omitempty"`
+}
+
+func (x *ProcessInfo) Reset() {
+	*x = ProcessInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessInfo) ProtoMessage() {}
+
+func (x *ProcessInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessInfo.ProtoReflect.Descriptor instead.
+func (*ProcessInfo) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *ProcessInfo) GetBackground() bool {
+	if x != nil {
+		return x.Background
+	}
+	return false
+}
+
+func (x *ProcessInfo) GetCpuPercent() float64 {
+	if x != nil {
+		return x.CpuPercent
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetChildren() []int32 {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *ProcessInfo) GetCmdline() string {
+	if x != nil {
+		return x.Cmdline
+	}
+	return ""
+}
+
+func (x *ProcessInfo) GetEnvirons() []string {
+	if x != nil {
+		return x.Environs
+	}
+	return nil
+}
+
+func (x *ProcessInfo) GetIonice() int32 {
+	if x != nil {
+		return x.Ionice
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetIsRunning() bool {
+	if x != nil {
+		return x.IsRunning
+	}
+	return false
+}
+
+func (x *ProcessInfo) GetProcessMemoryInfo() *ProcessMemoryInfo {
+	if x != nil {
+		return x.ProcessMemoryInfo
+	}
+	return nil
+}
+
+func (x *ProcessInfo) GetMemoryPercent() float32 {
+	if x != nil {
+		return x.MemoryPercent
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProcessInfo) GetNumFd() int32 {
+	if x != nil {
+		return x.NumFd
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetNumThread() int32 {
+	if x != nil {
+		return x.NumThread
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetParent() int32 {
+	if x != nil {
+		return x.Parent
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetPpid() int32 {
+	if x != nil {
+		return x.Ppid
+	}
+	return 0
+}
+
+func (x *ProcessInfo) GetProcessRlimits() []*ProcessRlimit {
+	if x != nil {
+		return x.ProcessRlimits
+	}
+	return nil
+}
+
+func (x *ProcessInfo) GetStatuses() []string {
+	if x != nil {
+		return x.Statuses
+	}
+	return nil
+}
+
+func (x *ProcessInfo) GetUids() []int32 {
+	if x != nil {
+		return x.Uids
+	}
+	return nil
+}
+
+func (x *ProcessInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type ProcessMemoryInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Rss    uint64 `protobuf:"varint,1,opt,name=rss,proto3" json:"rss,omitempty"`
+	Vms    uint64 `protobuf:"varint,2,opt,name=vms,proto3" json:"vms,omitempty"`
+	Hwm    uint64 `protobuf:"varint,3,opt,name=hwm,proto3" json:"hwm,omitempty"`
+	Data   uint64 `protobuf:"varint,4,opt,name=data,proto3" json:"data,omitempty"`
+	Stack  uint64 `protobuf:"varint,5,opt,name=stack,proto3" json:"stack,omitempty"`
+	Locked uint64 `protobuf:"varint,6,opt,name=locked,proto3" json:"locked,omitempty"`
+	Swap   uint64 `protobuf:"varint,7,opt,name=swap,proto3" json:"swap,omitempty"`
+}
+
+func (x *ProcessMemoryInfo) Reset() {
+	*x = ProcessMemoryInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessMemoryInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessMemoryInfo) ProtoMessage() {}
+
+func (x *ProcessMemoryInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessMemoryInfo.ProtoReflect.Descriptor instead.
+func (*ProcessMemoryInfo) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *ProcessMemoryInfo) GetRss() uint64 {
+	if x != nil {
+		return x.Rss
+	}
+	return 0
+}
+
+func (x *ProcessMemoryInfo) GetVms() uint64 {
+	if x != nil {
+		return x.Vms
+	}
+	return 0
+}
+
+func (x *ProcessMemoryInfo) GetHwm() uint64 {
+	if x != nil {
+		return x.Hwm
+	}
+	return 0
+}
+
+func (x *ProcessMemoryInfo) GetData() uint64 {
+	if x != nil {
+		return x.Data
+	}
+	return 0
+}
+
+func (x *ProcessMemoryInfo) GetStack() uint64 {
+	if x != nil {
+		return x.Stack
+	}
+	return 0
+}
+
+func (x *ProcessMemoryInfo) GetLocked() uint64 {
+	if x != nil {
+		return x.Locked
+	}
+	return 0
+}
+
+func (x *ProcessMemoryInfo) GetSwap() uint64 {
+	if x != nil {
+		return x.Swap
+	}
+	return 0
+}
+
+type ProcessRlimit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resource int32  `protobuf:"varint,1,opt,name=resource,proto3" json:"resource,omitempty"`
+	Soft     uint64 `protobuf:"varint,2,opt,name=soft,proto3" json:"soft,omitempty"`
+	Hard     uint64 `protobuf:"varint,3,opt,name=hard,proto3" json:"hard,omitempty"`
+	Used     uint64 `protobuf:"varint,4,opt,name=used,proto3" json:"used,omitempty"`
+}
+
+func (x *ProcessRlimit) Reset() {
+	*x = ProcessRlimit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_insight_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProcessRlimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProcessRlimit) ProtoMessage() {}
+
+func (x *ProcessRlimit) ProtoReflect() protoreflect.Message {
+	mi := &file_insight_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProcessRlimit.ProtoReflect.Descriptor instead.
+func (*ProcessRlimit) Descriptor() ([]byte, []int) {
+	return file_insight_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *ProcessRlimit) GetResource() int32 {
+	if x != nil {
+		return x.Resource
+	}
+	return 0
+}
+
+func (x *ProcessRlimit) GetSoft() uint64 {
+	if x != nil {
+		return x.Soft
+	}
+	return 0
+}
+
+func (x *ProcessRlimit) GetHard() uint64 {
+	if x != nil {
+		return x.Hard
+	}
+	return 0
+}
+
+func (x *ProcessRlimit) GetUsed() uint64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+var File_insight_proto protoreflect.FileDescriptor
+
+var file_insight_proto_rawDesc = []byte{
+	0x0a, 0x0d, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12,
+	0x1c, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e,
+	0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x80, 0x05,
+	0x0a, 0x0d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x4b, 0x0a, 0x0b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x0b, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x48, 0x0a, 0x0a,
+	0x63, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x28, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x43, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x63, 0x6f, 0x64, 0x65,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x48, 0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69,
+	0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x57, 0x0a, 0x0f, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67,
+	0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0f, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x54, 0x0a, 0x0e, 0x61, 0x72, 0x74,
+	0x69, 0x66, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x0e, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x45, 0x0a, 0x09, 0x67, 0x70, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x47, 0x70, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x67, 0x70, 0x74,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x48, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69,
+	0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x4e, 0x0a, 0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x52, 0x0c, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x22, 0x7c, 0x0a, 0x0b, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x51, 0x0a, 0x0d, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x0d, 0x6c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x82,
+	0x02, 0x0a, 0x0a, 0x43, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x4a, 0x0a, 0x0b, 0x6c, 0x69, 0x6e,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e,
+	0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69,
+	0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0b, 0x6c, 0x69, 0x6e, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x42, 0x0a, 0x08, 0x6c, 0x69, 0x6e, 0x74, 0x56, 0x6f, 0x74,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x52,
+	0x08, 0x6c, 0x69, 0x6e, 0x74, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x48, 0x0a, 0x0a, 0x6d, 0x65, 0x67,
+	0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69,
+	0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x67,
+	0x61, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x0a, 0x6d, 0x65, 0x67, 0x61, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x22, 0x28, 0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x11, 0x0a,
+	0x0f, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x22, 0x4a, 0x0a, 0x0e, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x73, 0x73,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x73, 0x73, 0x22, 0x45, 0x0a, 0x09,
+	0x47, 0x70, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
+	0x12, 0x0a, 0x04, 0x70, 0x61, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70,
+	0x61, 0x73, 0x73, 0x22, 0x46, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x73, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x73, 0x73, 0x22, 0x48, 0x0a, 0x0c, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x10, 0x0a, 0x03, 0x75,
+	0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x73, 0x73, 0x22, 0x4d, 0x0a, 0x0d, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03,
+	0x6c, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03, 0x6c, 0x65, 0x6e, 0x12, 0x14,
+	0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x22, 0xb0, 0x01, 0x0a, 0x0a, 0x4c, 0x69, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74,
+	0x65, 0x6e, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x73, 0x12, 0x3c, 0x0a, 0x05, 0x72, 0x75, 0x6c,
+	0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x52, 0x75, 0x6c, 0x65,
+	0x52, 0x05, 0x72, 0x75, 0x6c, 0x65, 0x73, 0x22, 0xbd, 0x04, 0x0a, 0x08, 0x4c, 0x69, 0x6e, 0x74,
+	0x52, 0x75, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x64, 0x69, 0x73, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x69, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x61, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a,
+	0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x4d, 0x61, 0x78, 0x12, 0x26, 0x0a, 0x0e, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0e, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x61, 0x78, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x53, 0x74, 0x79,
+	0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63,
+	0x74, 0x53, 0x74, 0x79, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x72, 0x61, 0x69, 0x6c, 0x65,
+	0x72, 0x73, 0x12, 0x49, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69,
+	0x73, 0x74, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x6c, 0x69, 0x63, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x70, 0x79, 0x72,
+	0x69, 0x67, 0x68, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x70, 0x79,
+	0x72, 0x69, 0x67, 0x68, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x12, 0x18, 0x0a,
+	0x07, 0x73, 0x69, 0x7a, 0x65, 0x4d, 0x61, 0x78, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07,
+	0x73, 0x69, 0x7a, 0x65, 0x4d, 0x61, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x6e, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65, 0x6e, 0x79, 0x6c,
+	0x69, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6c, 0x6c, 0x65, 0x67, 0x61, 0x6c, 0x43, 0x68,
+	0x61, 0x72, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x69, 0x6c, 0x6c, 0x65, 0x67,
+	0x61, 0x6c, 0x43, 0x68, 0x61, 0x72, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70,
+	0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x72, 0x6f, 0x6d, 0x70, 0x74, 0x12,
+	0x16, 0x0a, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x22, 0x3f, 0x0a, 0x0d, 0x4c, 0x69, 0x6e, 0x74, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x67, 0x65, 0x78, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x65, 0x67, 0x65, 0x78, 0x65, 0x73, 0x22, 0xdb, 0x01, 0x0a, 0x08, 0x4c, 0x69, 0x6e,
+	0x74, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61,
+	0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76, 0x61, 0x6c,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x12, 0x3f, 0x0a, 0x06, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x4c, 0x69, 0x6e, 0x74, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x52, 0x06, 0x6c, 0x61,
+	0x62, 0x65, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4f,
+	0x6e, 0x6c, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x65,
+	0x6e, 0x74, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x5d, 0x0a, 0x09, 0x4c, 0x69, 0x6e, 0x74, 0x4c, 0x61,
+	0x62, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x70, 0x70, 0x72, 0x6f,
+	0x76, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x69, 0x73, 0x61, 0x70, 0x70, 0x72, 0x6f, 0x76,
+	0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x61, 0x70, 0x70,
+	0x72, 0x6f, 0x76, 0x61, 0x6c, 0x22, 0x88, 0x01, 0x0a, 0x0a, 0x4d, 0x65, 0x67, 0x61, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x79,
+	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x74, 0x61, 0x67, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x74, 0x61, 0x67, 0x12, 0x16, 0x0a, 0x06, 0x64, 0x69, 0x67, 0x65,
+	0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x12, 0x1e, 0x0a, 0x0a, 0x70, 0x75, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x75, 0x6c, 0x6c, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
+	0x22, 0x10, 0x0a, 0x0e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xaf, 0x03, 0x0a, 0x0e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x57, 0x0a, 0x0f, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2d,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e,
+	0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72,
+	0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x0f, 0x61,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x4e,
+	0x0a, 0x0c, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x52, 0x0c, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x4b,
+	0x0a, 0x0b, 0x63, 0x6f, 0x64, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x64, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x0b,
+	0x63, 0x6f, 0x64, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x4b, 0x0a, 0x0b, 0x6e,
+	0x6f, 0x64, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x29, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4e, 0x6f, 0x64, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x0b, 0x6e, 0x6f, 0x64,
+	0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x5a, 0x0a, 0x10, 0x74, 0x6f, 0x6f, 0x6c,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x52, 0x10, 0x74, 0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x72, 0x69,
+	0x67, 0x67, 0x65, 0x72, 0x22, 0x11, 0x0a, 0x0f, 0x41, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x22, 0x86, 0x02, 0x0a, 0x0c, 0x42, 0x75, 0x69, 0x6c,
+	0x64, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x4d, 0x0a, 0x0c, 0x65, 0x6e, 0x76, 0x56,
+	0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e,
+	0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x45, 0x6e,
+	0x76, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x52, 0x0c, 0x65, 0x6e, 0x76, 0x56, 0x61,
+	0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x12, 0x54, 0x0a, 0x0e, 0x6c, 0x6f, 0x67, 0x67, 0x69,
+	0x6e, 0x67, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73,
+	0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c,
+	0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x0e, 0x6c,
+	0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x51, 0x0a,
+	0x0d, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x22, 0x60, 0x0a, 0x0b, 0x43, 0x6f, 0x64, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12,
+	0x51, 0x0a, 0x0d, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x52, 0x0d, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x54, 0x72, 0x69, 0x67, 0x67,
+	0x65, 0x72, 0x22, 0x54, 0x0a, 0x0b, 0x4e, 0x6f, 0x64, 0x65, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65,
+	0x72, 0x12, 0x45, 0x0a, 0x09, 0x73, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x53, 0x73, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x09, 0x73,
+	0x73, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x12, 0x0a, 0x10, 0x54, 0x6f, 0x6f, 0x6c,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x22, 0x37, 0x0a, 0x0b,
+	0x45, 0x6e, 0x76, 0x56, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x4e, 0x0a, 0x0e, 0x4c, 0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6c, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x03, 0x6c, 0x65, 0x6e, 0x22, 0xbf, 0x06, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x70,
+	0x69, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x70, 0x69, 0x63, 0x12,
+	0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x65, 0x12, 0x18, 0x0a,
+	0x07, 0x72, 0x65, 0x66, 0x73, 0x70, 0x65, 0x63, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x72, 0x65, 0x66, 0x73, 0x70, 0x65, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x55, 0x72, 0x6c,
+	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x55, 0x72,
+	0x6c, 0x12, 0x22, 0x0a, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x53,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x53, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x28, 0x0a,
+	0x0f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65,
+	0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2a, 0x0a, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x45, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x26, 0x0a, 0x0e, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x57, 0x49, 0x50,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x57, 0x49, 0x50, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x50, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x50,
+	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x26, 0x0a,
+	0x0e, 0x70, 0x61, 0x74, 0x63, 0x68, 0x73, 0x65, 0x74, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x74, 0x63, 0x68, 0x73, 0x65, 0x74, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x61, 0x74, 0x63, 0x68, 0x73, 0x65,
+	0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x14, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x70, 0x61, 0x74, 0x63, 0x68, 0x73, 0x65, 0x74, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x61, 0x74, 0x63, 0x68, 0x73, 0x65, 0x74, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x65, 0x72, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x73, 0x65, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x12, 0x32, 0x0a,
+	0x14, 0x70, 0x61, 0x74, 0x63, 0x68, 0x73, 0x65, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x72, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x70, 0x61, 0x74,
+	0x63, 0x68, 0x73, 0x65, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x72, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x34, 0x0a, 0x15, 0x70, 0x61, 0x74, 0x63, 0x68, 0x73, 0x65, 0x74, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x17, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x15, 0x70, 0x61, 0x74, 0x63, 0x68, 0x73, 0x65, 0x74, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
+	0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x22, 0x87, 0x01, 0x0a, 0x09, 0x53, 0x73, 0x68, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x70, 0x61, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f,
+	0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x22, 0xc7, 0x03, 0x0a, 0x0f, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0c, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73,
+	0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x41, 0x72, 0x74, 0x69, 0x66,
+	0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x61, 0x72, 0x74, 0x69, 0x66, 0x61, 0x63,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x45, 0x0a, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e,
+	0x66, 0x6f, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x42, 0x0a, 0x08,
+	0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e,
+	0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f,
+	0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x42, 0x0a, 0x08, 0x6d, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4d, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x6d, 0x61, 0x69, 0x6c,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x42, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08,
+	0x6e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x51, 0x0a, 0x0d, 0x74, 0x6f, 0x6f, 0x6c,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73,
+	0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54,
+	0x6f, 0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0d, 0x74, 0x6f,
+	0x6f, 0x6c, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x0e, 0x0a, 0x0c, 0x41,
+	0x72, 0x74, 0x69, 0x66, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0xc0, 0x02, 0x0a, 0x09,
+	0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x4d, 0x0a, 0x0c, 0x6c, 0x6f, 0x67,
+	0x67, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x29, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73,
+	0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4c,
+	0x6f, 0x67, 0x67, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0c, 0x6c, 0x6f, 0x67, 0x67,
+	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x44, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f,
+	0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73,
+	0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x4a,
+	0x0a, 0x0b, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2e, 0x52, 0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0b, 0x72,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x12, 0x1c, 0x0a, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x61, 0x75, 0x73, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x6f, 0x6f, 0x74, 0x43, 0x61, 0x75, 0x73, 0x65, 0x12, 0x1e,
+	0x0a, 0x0a, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x20,
+	0x0a, 0x08, 0x43, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x22, 0xe2, 0x01, 0x0a, 0x08, 0x4d, 0x61, 0x69, 0x6c, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a,
+	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x72, 0x6f, 0x6d, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x65, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x63, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x12,
+	0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x62,
+	0x6f, 0x64, 0x79, 0x12, 0x20, 0x0a, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x61, 0x74, 0x74, 0x61, 0x63, 0x68,
+	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x42, 0x0a, 0x08, 0x6e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x52, 0x08, 0x6e, 0x6f,
+	0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x12, 0x48, 0x0a, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69,
+	0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x0f, 0x0a, 0x0d, 0x54, 0x6f, 0x6f, 0x6c, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x22, 0x85, 0x01, 0x0a, 0x0b, 0x4c, 0x6f, 0x67, 0x67,
+	0x69, 0x6e, 0x67, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x4c, 0x69, 0x6e, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x65, 0x22,
+	0xb8, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x70, 0x6f, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07,
+	0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
+	0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x16,
+	0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x74, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
+	0x74, 0x74, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22, 0xb2, 0x01, 0x0a, 0x0a, 0x52,
+	0x65, 0x76, 0x69, 0x65, 0x77, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f,
+	0x6a, 0x65, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x6a,
+	0x65, 0x63, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x61, 0x6e, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x63, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x75, 0x74,
+	0x68, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x75, 0x74, 0x68, 0x6f,
+	0x72, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x64,
+	0x61, 0x74, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x65, 0x22,
+	0xb0, 0x04, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x74, 0x61, 0x74, 0x12, 0x3f, 0x0a, 0x07,
+	0x63, 0x70, 0x75, 0x53, 0x74, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69,
+	0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x70, 0x75,
+	0x53, 0x74, 0x61, 0x74, 0x52, 0x07, 0x63, 0x70, 0x75, 0x53, 0x74, 0x61, 0x74, 0x12, 0x42, 0x0a,
+	0x08, 0x64, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x26, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73,
+	0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44,
+	0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x52, 0x08, 0x64, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x12, 0x48, 0x0a, 0x0a, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x52,
+	0x0a, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x12, 0x42, 0x0a, 0x08, 0x68,
+	0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69,
+	0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x48, 0x6f, 0x73,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x12,
+	0x42, 0x0a, 0x08, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61, 0x74, 0x52, 0x08, 0x6c, 0x6f, 0x61, 0x64, 0x53,
+	0x74, 0x61, 0x74, 0x12, 0x3f, 0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x52, 0x07, 0x6d, 0x65, 0x6d,
+	0x53, 0x74, 0x61, 0x74, 0x12, 0x3f, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x52, 0x07, 0x6e, 0x65,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x12, 0x4b, 0x0a, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73,
+	0x53, 0x74, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69,
+	0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x53, 0x74, 0x61, 0x74, 0x52, 0x0b, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74,
+	0x61, 0x74, 0x22, 0xb4, 0x02, 0x0a, 0x0a, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x70, 0x75, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x70, 0x75, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x6b, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12,
+	0x22, 0x0a, 0x0c, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x64, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x65, 0x61, 0x6c, 0x74,
+	0x68, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x68, 0x6f, 0x73, 0x74, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x68, 0x6f, 0x73,
+	0x74, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x6c, 0x6f, 0x61, 0x64, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6c, 0x6f, 0x61,
+	0x64, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6d, 0x65, 0x6d, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x6d, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x74, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x96, 0x01, 0x0a, 0x07, 0x43, 0x70,
+	0x75, 0x53, 0x74, 0x61, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x68, 0x79, 0x73, 0x69, 0x63, 0x61,
+	0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x70, 0x68,
+	0x79, 0x73, 0x69, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x6c,
+	0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x6c, 0x6f, 0x67, 0x69, 0x63, 0x61, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x41, 0x0a, 0x08, 0x63, 0x70, 0x75, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2e, 0x43, 0x70, 0x75, 0x54, 0x69, 0x6d, 0x65, 0x52, 0x08, 0x63, 0x70, 0x75, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x22, 0xa6, 0x01, 0x0a, 0x08, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x12,
+	0x53, 0x0a, 0x0e, 0x64, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x74, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x45, 0x0a, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x09, 0x64, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x22, 0xfb, 0x01, 0x0a, 0x0a,
+	0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x12, 0x34, 0x0a, 0x15, 0x63, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x43, 0x70, 0x75, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x01, 0x52, 0x15, 0x63, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x43, 0x70, 0x75, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x55, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x12, 0x5c, 0x0a, 0x11, 0x63, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72,
+	0x53, 0x74, 0x61, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73,
+	0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x67, 0x72, 0x6f, 0x75,
+	0x70, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x52, 0x11, 0x63, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x73, 0x12, 0x59,
+	0x0a, 0x10, 0x63, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x44, 0x6f, 0x63, 0x6b, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65,
+	0x6d, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x52, 0x10, 0x63, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4d,
+	0x65, 0x6d, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x73, 0x22, 0x98, 0x02, 0x0a, 0x08, 0x48, 0x6f,
+	0x73, 0x74, 0x53, 0x74, 0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x63, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x05, 0x70, 0x72, 0x6f, 0x63, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x6f, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d,
+	0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x6c,
+	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x46, 0x61, 0x6d, 0x69, 0x6c, 0x79, 0x12, 0x28, 0x0a, 0x0f,
+	0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c,
+	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6b,
+	0x65, 0x72, 0x6e, 0x65, 0x6c, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a,
+	0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x41, 0x72, 0x63, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6b, 0x65, 0x72, 0x6e, 0x65, 0x6c, 0x41, 0x72, 0x63, 0x68, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x6f, 0x73, 0x74, 0x49, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x68, 0x6f,
+	0x73, 0x74, 0x49, 0x44, 0x22, 0x8f, 0x01, 0x0a, 0x08, 0x4c, 0x6f, 0x61, 0x64, 0x53, 0x74, 0x61,
+	0x74, 0x12, 0x3f, 0x0a, 0x07, 0x6c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x67, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x67, 0x52, 0x07, 0x6c, 0x6f, 0x61, 0x64, 0x41,
+	0x76, 0x67, 0x12, 0x42, 0x0a, 0x08, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x69, 0x73, 0x63, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4c, 0x6f, 0x61, 0x64, 0x4d, 0x69, 0x73, 0x63, 0x52, 0x08, 0x6c, 0x6f,
+	0x61, 0x64, 0x4d, 0x69, 0x73, 0x63, 0x22, 0xfb, 0x01, 0x0a, 0x07, 0x4d, 0x65, 0x6d, 0x53, 0x74,
+	0x61, 0x74, 0x12, 0x53, 0x0a, 0x0e, 0x6d, 0x65, 0x6d, 0x53, 0x77, 0x61, 0x70, 0x44, 0x65, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69,
+	0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65, 0x6d, 0x53, 0x77, 0x61,
+	0x70, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x52, 0x0e, 0x6d, 0x65, 0x6d, 0x53, 0x77, 0x61, 0x70,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x51, 0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x53, 0x77,
+	0x61, 0x70, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e,
+	0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65,
+	0x6d, 0x53, 0x77, 0x61, 0x70, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x52, 0x0d, 0x6d, 0x65, 0x6d,
+	0x53, 0x77, 0x61, 0x70, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x48, 0x0a, 0x0a, 0x6d, 0x65,
+	0x6d, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e,
+	0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x4d, 0x65,
+	0x6d, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c, 0x52, 0x0a, 0x6d, 0x65, 0x6d, 0x56, 0x69, 0x72,
+	0x74, 0x75, 0x61, 0x6c, 0x22, 0x98, 0x01, 0x0a, 0x07, 0x4e, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x12, 0x3b, 0x0a, 0x06, 0x6e, 0x65, 0x74, 0x49, 0x6f, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x4e, 0x65, 0x74, 0x49, 0x6f, 0x52, 0x06, 0x6e, 0x65, 0x74, 0x49, 0x6f, 0x73, 0x12, 0x50, 0x0a,
+	0x0d, 0x6e, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x4e, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
+	0x52, 0x0d, 0x6e, 0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x73, 0x22,
+	0x5c, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x53, 0x74, 0x61, 0x74, 0x12, 0x4d,
+	0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x0c, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x73, 0x22, 0xfd, 0x01,
+	0x0a, 0x07, 0x43, 0x70, 0x75, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x70, 0x75,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x63, 0x70, 0x75, 0x12, 0x12, 0x0a, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x69, 0x64, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x01, 0x52, 0x04, 0x6e, 0x69, 0x63, 0x65, 0x12,
+	0x16, 0x0a, 0x06, 0x69, 0x6f, 0x77, 0x61, 0x69, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x06, 0x69, 0x6f, 0x77, 0x61, 0x69, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x72, 0x71, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x03, 0x69, 0x72, 0x71, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x6f, 0x66,
+	0x74, 0x69, 0x72, 0x71, 0x18, 0x08, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x73, 0x6f, 0x66, 0x74,
+	0x69, 0x72, 0x71, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x05, 0x73, 0x74, 0x65, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x67, 0x75, 0x65,
+	0x73, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x67, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x67, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x69, 0x63, 0x65, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x09, 0x67, 0x75, 0x65, 0x73, 0x74, 0x4e, 0x69, 0x63, 0x65, 0x22, 0x73, 0x0a,
+	0x0d, 0x44, 0x69, 0x73, 0x6b, 0x50, 0x61, 0x72, 0x74, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x70,
+	0x6f, 0x69, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x73, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x73, 0x74, 0x79, 0x70, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x6f, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x6f, 0x70,
+	0x74, 0x73, 0x22, 0x97, 0x01, 0x0a, 0x09, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x73, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x73, 0x74, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x65, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x73,
+	0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52,
+	0x0b, 0x75, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x90, 0x01, 0x0a,
+	0x10, 0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x44, 0x6f, 0x63, 0x6b, 0x65, 0x72, 0x53, 0x74, 0x61,
+	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65,
+	0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x22,
+	0x81, 0x03, 0x0a, 0x0f, 0x43, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x44, 0x6f, 0x63,
+	0x6b, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x05, 0x63, 0x61, 0x63, 0x68, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x73, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x72, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x73, 0x73, 0x48, 0x75, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x72, 0x73,
+	0x73, 0x48, 0x75, 0x67, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x46,
+	0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x6d, 0x61, 0x70, 0x70, 0x65,
+	0x64, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x61,
+	0x63, 0x68, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c,
+	0x43, 0x61, 0x63, 0x68, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x73,
+	0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x73,
+	0x73, 0x12, 0x22, 0x0a, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x73, 0x73, 0x48, 0x75, 0x67,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x73,
+	0x73, 0x48, 0x75, 0x67, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x61,
+	0x70, 0x70, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x4d, 0x61, 0x70, 0x70, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x12,
+	0x28, 0x0a, 0x0f, 0x6d, 0x65, 0x6d, 0x55, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x6d, 0x65, 0x6d, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x2e, 0x0a, 0x12, 0x6d, 0x65, 0x6d,
+	0x4d, 0x61, 0x78, 0x55, 0x73, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x6d, 0x65, 0x6d, 0x4d, 0x61, 0x78, 0x55, 0x73, 0x61,
+	0x67, 0x65, 0x49, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x6d, 0x65, 0x6d,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0f, 0x6d, 0x65, 0x6d, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x49, 0x6e, 0x42, 0x79,
+	0x74, 0x65, 0x73, 0x22, 0x4d, 0x0a, 0x07, 0x4c, 0x6f, 0x61, 0x64, 0x41, 0x76, 0x67, 0x12, 0x14,
+	0x0a, 0x05, 0x6c, 0x6f, 0x61, 0x64, 0x31, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x6c,
+	0x6f, 0x61, 0x64, 0x31, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x6f, 0x61, 0x64, 0x35, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x05, 0x6c, 0x6f, 0x61, 0x64, 0x35, 0x12, 0x16, 0x0a, 0x06, 0x6c, 0x6f,
+	0x61, 0x64, 0x31, 0x35, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x06, 0x6c, 0x6f, 0x61, 0x64,
+	0x31, 0x35, 0x22, 0xaa, 0x01, 0x0a, 0x08, 0x4c, 0x6f, 0x61, 0x64, 0x4d, 0x69, 0x73, 0x63, 0x12,
+	0x1e, 0x0a, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x70, 0x72, 0x6f, 0x63, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12,
+	0x22, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x73, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x73, 0x52, 0x75, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x73,
+	0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x6f, 0x63, 0x73,
+	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x70,
+	0x72, 0x6f, 0x63, 0x73, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x63,
+	0x74, 0x78, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x63, 0x74, 0x78, 0x74, 0x22,
+	0x5f, 0x0a, 0x0d, 0x4d, 0x65, 0x6d, 0x53, 0x77, 0x61, 0x70, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x75, 0x73, 0x65, 0x64, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x66, 0x72, 0x65, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x66, 0x72, 0x65, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73,
+	0x22, 0x6f, 0x0a, 0x0d, 0x4d, 0x65, 0x6d, 0x53, 0x77, 0x61, 0x70, 0x4d, 0x65, 0x6d, 0x6f, 0x72,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66,
+	0x72, 0x65, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x12,
+	0x20, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e,
+	0x74, 0x22, 0xf8, 0x04, 0x0a, 0x0a, 0x4d, 0x65, 0x6d, 0x56, 0x69, 0x72, 0x74, 0x75, 0x61, 0x6c,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c,
+	0x61, 0x62, 0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x64,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0b, 0x75,
+	0x73, 0x65, 0x64, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72,
+	0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x66, 0x72, 0x65, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0a, 0x73, 0x77, 0x61, 0x70, 0x43, 0x61, 0x63, 0x68, 0x65, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x73, 0x77, 0x61, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x73, 0x77, 0x61, 0x70, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
+	0x73, 0x77, 0x61, 0x70, 0x46, 0x72, 0x65, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08,
+	0x73, 0x77, 0x61, 0x70, 0x46, 0x72, 0x65, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x70, 0x70,
+	0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6d, 0x61, 0x70, 0x70, 0x65, 0x64,
+	0x12, 0x22, 0x0a, 0x0c, 0x76, 0x6d, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x54, 0x6f, 0x74, 0x61, 0x6c,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x76, 0x6d, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x54,
+	0x6f, 0x74, 0x61, 0x6c, 0x12, 0x20, 0x0a, 0x0b, 0x76, 0x6d, 0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x55,
+	0x73, 0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x76, 0x6d, 0x61, 0x6c, 0x6c,
+	0x6f, 0x63, 0x55, 0x73, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0c, 0x76, 0x6d, 0x61, 0x6c, 0x6c, 0x6f,
+	0x63, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x76, 0x6d,
+	0x61, 0x6c, 0x6c, 0x6f, 0x63, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x26, 0x0a, 0x0e, 0x68, 0x75,
+	0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x54, 0x6f, 0x74, 0x61, 0x6c, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0e, 0x68, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x54, 0x6f, 0x74,
+	0x61, 0x6c, 0x12, 0x24, 0x0a, 0x0d, 0x68, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x46,
+	0x72, 0x65, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x68, 0x75, 0x67, 0x65, 0x50,
+	0x61, 0x67, 0x65, 0x73, 0x46, 0x72, 0x65, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x68, 0x75, 0x67, 0x65,
+	0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x73, 0x76, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0d, 0x68, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x52, 0x73, 0x76, 0x64, 0x12, 0x24,
+	0x0a, 0x0d, 0x68, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73, 0x53, 0x75, 0x72, 0x70, 0x18,
+	0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x68, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x73,
+	0x53, 0x75, 0x72, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x68, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65,
+	0x53, 0x69, 0x7a, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x68, 0x75, 0x67, 0x65,
+	0x50, 0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x61, 0x6e, 0x6f, 0x6e,
+	0x48, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x61, 0x6e, 0x6f, 0x6e, 0x48, 0x75, 0x67, 0x65, 0x50, 0x61, 0x67, 0x65, 0x22, 0x9b, 0x01, 0x0a,
+	0x05, 0x4e, 0x65, 0x74, 0x49, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x79,
+	0x74, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62,
+	0x79, 0x74, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x79, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x63, 0x76, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x62, 0x79, 0x74,
+	0x65, 0x73, 0x52, 0x65, 0x63, 0x76, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x63, 0x6b, 0x65, 0x74,
+	0x73, 0x53, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x61, 0x63,
+	0x6b, 0x65, 0x74, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x61, 0x63, 0x6b,
+	0x65, 0x74, 0x73, 0x52, 0x65, 0x63, 0x76, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70,
+	0x61, 0x63, 0x6b, 0x65, 0x74, 0x73, 0x52, 0x65, 0x63, 0x76, 0x22, 0x9a, 0x01, 0x0a, 0x0c, 0x4e,
+	0x65, 0x74, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x10, 0x0a, 0x03, 0x6d, 0x74, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x03,
+	0x6d, 0x74, 0x75, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x68, 0x61, 0x72, 0x64, 0x77,
+	0x61, 0x72, 0x65, 0x41, 0x64, 0x64, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x68,
+	0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x41, 0x64, 0x64, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x66,
+	0x6c, 0x61, 0x67, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x66, 0x6c, 0x61, 0x67,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x05, 0x61, 0x64, 0x64, 0x72, 0x73, 0x22, 0xed, 0x04, 0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x63, 0x6b, 0x67,
+	0x72, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x62, 0x61, 0x63,
+	0x6b, 0x67, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x70, 0x75, 0x50, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0a, 0x63, 0x70, 0x75,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x72, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x05, 0x52, 0x08, 0x63, 0x68, 0x69, 0x6c, 0x64,
+	0x72, 0x65, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6d, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6d, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6f, 0x6e,
+	0x69, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x69, 0x6f, 0x6e, 0x69, 0x63,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x52, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x12,
+	0x5d, 0x0a, 0x11, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
+	0x49, 0x6e, 0x66, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69,
+	0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73,
+	0x73, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x11, 0x70, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x24,
+	0x0a, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0d, 0x6d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x50, 0x65, 0x72,
+	0x63, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x75, 0x6d, 0x46,
+	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x05, 0x52, 0x05, 0x6e, 0x75, 0x6d, 0x46, 0x64, 0x12, 0x1c,
+	0x0a, 0x09, 0x6e, 0x75, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x09, 0x6e, 0x75, 0x6d, 0x54, 0x68, 0x72, 0x65, 0x61, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x70, 0x69, 0x64, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x70, 0x70, 0x69, 0x64, 0x12, 0x52, 0x0a, 0x0e, 0x70, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x52, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x2b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e,
+	0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x52, 0x0d, 0x70,
+	0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x52, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x19, 0x0a, 0x08,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x69, 0x64, 0x73, 0x18,
+	0x11, 0x20, 0x03, 0x28, 0x05, 0x52, 0x04, 0x75, 0x69, 0x64, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75,
+	0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x9f, 0x01, 0x0a, 0x11, 0x50, 0x72, 0x6f, 0x63,
+	0x65, 0x73, 0x73, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x10, 0x0a,
+	0x03, 0x72, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x72, 0x73, 0x73, 0x12,
+	0x10, 0x0a, 0x03, 0x76, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x76, 0x6d,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x68, 0x77, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03,
+	0x68, 0x77, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74, 0x61, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x73, 0x74, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a,
+	0x06, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6c,
+	0x6f, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x22, 0x67, 0x0a, 0x0d, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x52, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x72, 0x65,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6f, 0x66, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x73, 0x6f, 0x66, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61,
+	0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x68, 0x61, 0x72, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x64, 0x32, 0xd6, 0x01, 0x0a, 0x07, 0x49, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x12, 0x63,
+	0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68,
+	0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x07, 0x54, 0x72, 0x69, 0x67, 0x67, 0x65, 0x72, 0x12, 0x2c,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e,
+	0x69, 0x6e, 0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72,
+	0x69, 0x67, 0x67, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x73, 0x2e, 0x69, 0x6e,
+	0x73, 0x69, 0x67, 0x68, 0x74, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2e, 0x54, 0x72, 0x69, 0x67,
+	0x67, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x34, 0x5a, 0x32, 0x67,
+	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x65, 0x76, 0x6f, 0x70, 0x73,
+	0x2d, 0x70, 0x69, 0x70, 0x65, 0x66, 0x6c, 0x6f, 0x77, 0x2f, 0x69, 0x6e, 0x73, 0x69, 0x67, 0x68,
+	0x74, 0x2d, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x70,
+	0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_insight_proto_rawDescOnce sync.Once
+	file_insight_proto_rawDescData = file_insight_proto_rawDesc
+)
+
+func file_insight_proto_rawDescGZIP() []byte {
+	file_insight_proto_rawDescOnce.Do(func() {
+		file_insight_proto_rawDescData = protoimpl.X.CompressGZIP(file_insight_proto_rawDescData)
+	})
+	return file_insight_proto_rawDescData
+}
+
+var file_insight_proto_msgTypes = make([]protoimpl.MessageInfo, 62)
+var file_insight_proto_goTypes = []interface{}{
+	(*ConfigRequest)(nil),     // 0: server.plugins.insight.proto.ConfigRequest
+	(*BuildConfig)(nil),       // 1: server.plugins.insight.proto.BuildConfig
+	(*CodeConfig)(nil),        // 2: server.plugins.insight.proto.CodeConfig
+	(*NodeConfig)(nil),        // 3: server.plugins.insight.proto.NodeConfig
+	(*ToolchainConfig)(nil),   // 4: server.plugins.insight.proto.ToolchainConfig
+	(*ArtifactConfig)(nil),    // 5: server.plugins.insight.proto.ArtifactConfig
+	(*GptConfig)(nil),         // 6: server.plugins.insight.proto.GptConfig
+	(*RepoConfig)(nil),        // 7: server.plugins.insight.proto.RepoConfig
+	(*ReviewConfig)(nil),      // 8: server.plugins.insight.proto.ReviewConfig
+	(*LoggingConfig)(nil),     // 9: server.plugins.insight.proto.LoggingConfig
+	(*LintConfig)(nil),        // 10: server.plugins.insight.proto.LintConfig
+	(*LintRule)(nil),          // 11: server.plugins.insight.proto.LintRule
+	(*LintAllowlist)(nil),     // 12: server.plugins.insight.proto.LintAllowlist
+	(*LintVote)(nil),          // 13: server.plugins.insight.proto.LintVote
+	(*LintLabel)(nil),         // 14: server.plugins.insight.proto.LintLabel
+	(*MegaConfig)(nil),        // 15: server.plugins.insight.proto.MegaConfig
+	(*ConfigResponse)(nil),    // 16: server.plugins.insight.proto.ConfigResponse
+	(*TriggerRequest)(nil),    // 17: server.plugins.insight.proto.TriggerRequest
+	(*ArtifactTrigger)(nil),   // 18: server.plugins.insight.proto.ArtifactTrigger
+	(*BuildTrigger)(nil),      // 19: server.plugins.insight.proto.BuildTrigger
+	(*CodeTrigger)(nil),       // 20: server.plugins.insight.proto.CodeTrigger
+	(*NodeTrigger)(nil),       // 21: server.plugins.insight.proto.NodeTrigger
+	(*ToolchainTrigger)(nil),  // 22: server.plugins.insight.proto.ToolchainTrigger
+	(*EnvVariable)(nil),       // 23: server.plugins.insight.proto.EnvVariable
+	(*LoggingTrigger)(nil),    // 24: server.plugins.insight.proto.LoggingTrigger
+	(*ReviewTrigger)(nil),     // 25: server.plugins.insight.proto.ReviewTrigger
+	(*SshConfig)(nil),         // 26: server.plugins.insight.proto.SshConfig
+	(*TriggerResponse)(nil),   // 27: server.plugins.insight.proto.TriggerResponse
+	(*ArtifactInfo)(nil),      // 28: server.plugins.insight.proto.ArtifactInfo
+	(*BuildInfo)(nil),         // 29: server.plugins.insight.proto.BuildInfo
+	(*CodeInfo)(nil),          // 30: server.plugins.insight.proto.CodeInfo
+	(*MailInfo)(nil),          // 31: server.plugins.insight.proto.MailInfo
+	(*NodeInfo)(nil),          // 32: server.plugins.insight.proto.NodeInfo
+	(*ToolchainInfo)(nil),     // 33: server.plugins.insight.proto.ToolchainInfo
+	(*LoggingInfo)(nil),       // 34: server.plugins.insight.proto.LoggingInfo
+	(*RepoInfo)(nil),          // 35: server.plugins.insight.proto.RepoInfo
+	(*ReviewInfo)(nil),        // 36: server.plugins.insight.proto.ReviewInfo
+	(*NodeStat)(nil),          // 37: server.plugins.insight.proto.NodeStat
+	(*NodeReport)(nil),        // 38: server.plugins.insight.proto.NodeReport
+	(*CpuStat)(nil),           // 39: server.plugins.insight.proto.CpuStat
+	(*DiskStat)(nil),          // 40: server.plugins.insight.proto.DiskStat
+	(*DockerStat)(nil),        // 41: server.plugins.insight.proto.DockerStat
+	(*HostStat)(nil),          // 42: server.plugins.insight.proto.HostStat
+	(*LoadStat)(nil),          // 43: server.plugins.insight.proto.LoadStat
+	(*MemStat)(nil),           // 44: server.plugins.insight.proto.MemStat
+	(*NetStat)(nil),           // 45: server.plugins.insight.proto.NetStat
+	(*ProcessStat)(nil),       // 46: server.plugins.insight.proto.ProcessStat
+	(*CpuTime)(nil),           // 47: server.plugins.insight.proto.CpuTime
+	(*DiskPartition)(nil),     // 48: server.plugins.insight.proto.DiskPartition
+	(*DiskUsage)(nil),         // 49: server.plugins.insight.proto.DiskUsage
+	(*CgroupDockerStat)(nil),  // 50: server.plugins.insight.proto.CgroupDockerStat
+	(*CgroupMemDocker)(nil),   // 51: server.plugins.insight.proto.CgroupMemDocker
+	(*LoadAvg)(nil),           // 52: server.plugins.insight.proto.LoadAvg
+	(*LoadMisc)(nil),          // 53: server.plugins.insight.proto.LoadMisc
+	(*MemSwapDevice)(nil),     // 54: server.plugins.insight.proto.MemSwapDevice
+	(*MemSwapMemory)(nil),     // 55: server.plugins.insight.proto.MemSwapMemory
+	(*MemVirtual)(nil),        // 56: server.plugins.insight.proto.MemVirtual
+	(*NetIo)(nil),             // 57: server.plugins.insight.proto.NetIo
+	(*NetInterface)(nil),      // 58: server.plugins.insight.proto.NetInterface
+	(*ProcessInfo)(nil),       // 59: server.plugins.insight.proto.ProcessInfo
+	(*ProcessMemoryInfo)(nil), // 60: server.plugins.insight.proto.ProcessMemoryInfo
+	(*ProcessRlimit)(nil),     // 61: server.plugins.insight.proto.ProcessRlimit
+}
+var file_insight_proto_depIdxs = []int32{
+	1,  // 0: server.plugins.insight.proto.ConfigRequest.buildConfig:type_name -> server.plugins.insight.proto.BuildConfig
+	2,  // 1: server.plugins.insight.proto.ConfigRequest.codeConfig:type_name -> server.plugins.insight.proto.CodeConfig
+	3,  // 2: server.plugins.insight.proto.ConfigRequest.nodeConfig:type_name -> server.plugins.insight.proto.NodeConfig
+	4,  // 3: server.plugins.insight.proto.ConfigRequest.toolchainConfig:type_name -> server.plugins.insight.proto.ToolchainConfig
+	5,  // 4: server.plugins.insight.proto.ConfigRequest.artifactConfig:type_name -> server.plugins.insight.proto.ArtifactConfig
+	6,  // 5: server.plugins.insight.proto.ConfigRequest.gptConfig:type_name -> server.plugins.insight.proto.GptConfig
+	7,  // 6: server.plugins.insight.proto.ConfigRequest.repoConfig:type_name -> server.plugins.insight.proto.RepoConfig
+	8,  // 7: server.plugins.insight.proto.ConfigRequest.reviewConfig:type_name -> server.plugins.insight.proto.ReviewConfig
+	9,  // 8: server.plugins.insight.proto.BuildConfig.loggingConfig:type_name -> server.plugins.insight.proto.LoggingConfig
+	10, // 9: server.plugins.insight.proto.CodeConfig.lintConfigs:type_name -> server.plugins.insight.proto.LintConfig
+	13, // 10: server.plugins.insight.proto.CodeConfig.lintVote:type_name -> server.plugins.insight.proto.LintVote
+	15, // 11: server.plugins.insight.proto.CodeConfig.megaConfig:type_name -> server.plugins.insight.proto.MegaConfig
+	11, // 12: server.plugins.insight.proto.LintConfig.rules:type_name -> server.plugins.insight.proto.LintRule
+	12, // 13: server.plugins.insight.proto.LintRule.allowlist:type_name -> server.plugins.insight.proto.LintAllowlist
+	14, // 14: server.plugins.insight.proto.LintVote.labels:type_name -> server.plugins.insight.proto.LintLabel
+	18, // 15: server.plugins.insight.proto.TriggerRequest.artifactTrigger:type_name -> server.plugins.insight.proto.ArtifactTrigger
+	19, // 16: server.plugins.insight.proto.TriggerRequest.buildTrigger:type_name -> server.plugins.insight.proto.BuildTrigger
+	20, // 17: server.plugins.insight.proto.TriggerRequest.codeTrigger:type_name -> server.plugins.insight.proto.CodeTrigger
+	21, // 18: server.plugins.insight.proto.TriggerRequest.nodeTrigger:type_name -> server.plugins.insight.proto.NodeTrigger
+	22, // 19: server.plugins.insight.proto.TriggerRequest.toolchainTrigger:type_name -> server.plugins.insight.proto.ToolchainTrigger
+	23, // 20: server.plugins.insight.proto.BuildTrigger.envVariables:type_name -> server.plugins.insight.proto.EnvVariable
+	24, // 21: server.plugins.insight.proto.BuildTrigger.loggingTrigger:type_name -> server.plugins.insight.proto.LoggingTrigger
+	25, // 22: server.plugins.insight.proto.BuildTrigger.reviewTrigger:type_name -> server.plugins.insight.proto.ReviewTrigger
+	25, // 23: server.plugins.insight.proto.CodeTrigger.reviewTrigger:type_name -> server.plugins.insight.proto.ReviewTrigger
+	26, // 24: server.plugins.insight.proto.NodeTrigger.sshConfig:type_name -> server.plugins.insight.proto.SshConfig
+	28, // 25: server.plugins.insight.proto.TriggerResponse.artifactInfo:type_name -> server.plugins.insight.proto.ArtifactInfo
+	29, // 26: server.plugins.insight.proto.TriggerResponse.buildInfo:type_name -> server.plugins.insight.proto.BuildInfo
+	30, // 27: server.plugins.insight.proto.TriggerResponse.codeInfo:type_name -> server.plugins.insight.proto.CodeInfo
+	31, // 28: server.plugins.insight.proto.TriggerResponse.mailInfo:type_name -> server.plugins.insight.proto.MailInfo
+	32, // 29: server.plugins.insight.proto.TriggerResponse.nodeInfo:type_name -> server.plugins.insight.proto.NodeInfo
+	33, // 30: server.plugins.insight.proto.TriggerResponse.toolchainInfo:type_name -> server.plugins.insight.proto.ToolchainInfo
+	34, // 31: server.plugins.insight.proto.BuildInfo.loggingInfos:type_name -> server.plugins.insight.proto.LoggingInfo
+	35, // 32: server.plugins.insight.proto.BuildInfo.repoInfos:type_name -> server.plugins.insight.proto.RepoInfo
+	36, // 33: server.plugins.insight.proto.BuildInfo.reviewInfos:type_name -> server.plugins.insight.proto.ReviewInfo
+	37, // 34: server.plugins.insight.proto.NodeInfo.nodeStat:type_name -> server.plugins.insight.proto.NodeStat
+	38, // 35: server.plugins.insight.proto.NodeInfo.nodeReport:type_name -> server.plugins.insight.proto.NodeReport
+	39, // 36: server.plugins.insight.proto.NodeStat.cpuStat:type_name -> server.plugins.insight.proto.CpuStat
+	40, // 37: server.plugins.insight.proto.NodeStat.diskStat:type_name -> server.plugins.insight.proto.DiskStat
+	41, // 38: server.plugins.insight.proto.NodeStat.dockerStat:type_name -> server.plugins.insight.proto.DockerStat
+	42, // 39: server.plugins.insight.proto.NodeStat.hostStat:type_name -> server.plugins.insight.proto.HostStat
+	43, // 40: server.plugins.insight.proto.NodeStat.loadStat:type_name -> server.plugins.insight.proto.LoadStat
+	44, // 41: server.plugins.insight.proto.NodeStat.memStat:type_name -> server.plugins.insight.proto.MemStat
+	45, // 42: server.plugins.insight.proto.NodeStat.netStat:type_name -> server.plugins.insight.proto.NetStat
+	46, // 43: server.plugins.insight.proto.NodeStat.processStat:type_name -> server.plugins.insight.proto.ProcessStat
+	47, // 44: server.plugins.insight.proto.CpuStat.cpuTimes:type_name -> server.plugins.insight.proto.CpuTime
+	48, // 45: server.plugins.insight.proto.DiskStat.diskPartitions:type_name -> server.plugins.insight.proto.DiskPartition
+	49, // 46: server.plugins.insight.proto.DiskStat.diskUsage:type_name -> server.plugins.insight.proto.DiskUsage
+	50, // 47: server.plugins.insight.proto.DockerStat.cgroupDockerStats:type_name -> server.plugins.insight.proto.CgroupDockerStat
+	51, // 48: server.plugins.insight.proto.DockerStat.cgroupMemDockers:type_name -> server.plugins.insight.proto.CgroupMemDocker
+	52, // 49: server.plugins.insight.proto.LoadStat.loadAvg:type_name -> server.plugins.insight.proto.LoadAvg
+	53, // 50: server.plugins.insight.proto.LoadStat.loadMisc:type_name -> server.plugins.insight.proto.LoadMisc
+	54, // 51: server.plugins.insight.proto.MemStat.memSwapDevices:type_name -> server.plugins.insight.proto.MemSwapDevice
+	55, // 52: server.plugins.insight.proto.MemStat.memSwapMemory:type_name -> server.plugins.insight.proto.MemSwapMemory
+	56, // 53: server.plugins.insight.proto.MemStat.memVirtual:type_name -> server.plugins.insight.proto.MemVirtual
+	57, // 54: server.plugins.insight.proto.NetStat.netIos:type_name -> server.plugins.insight.proto.NetIo
+	58, // 55: server.plugins.insight.proto.NetStat.netInterfaces:type_name -> server.plugins.insight.proto.NetInterface
+	59, // 56: server.plugins.insight.proto.ProcessStat.processInfos:type_name -> server.plugins.insight.proto.ProcessInfo
+	60, // 57: server.plugins.insight.proto.ProcessInfo.processMemoryInfo:type_name -> server.plugins.insight.proto.ProcessMemoryInfo
+	61, // 58: server.plugins.insight.proto.ProcessInfo.processRlimits:type_name -> server.plugins.insight.proto.ProcessRlimit
+	0,  // 59: server.plugins.insight.proto.Insight.Config:input_type -> server.plugins.insight.proto.ConfigRequest
+	17, // 60: server.plugins.insight.proto.Insight.Trigger:input_type -> server.plugins.insight.proto.TriggerRequest
+	16, // 61: server.plugins.insight.proto.Insight.Config:output_type -> server.plugins.insight.proto.ConfigResponse
+	27, // 62: server.plugins.insight.proto.Insight.Trigger:output_type -> server.plugins.insight.proto.TriggerResponse
+	61, // [61:63] is the sub-list for method output_type
+	59, // [59:61] is the sub-list for method input_type
+	59, // [59:59] is the sub-list for extension type_name
+	59, // [59:59] is the sub-list for extension extendee
+	0,  // [0:59] is the sub-list for field type_name
+}
+
+func init() { file_insight_proto_init() }
+func file_insight_proto_init() {
+	if File_insight_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_insight_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CodeConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToolchainConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtifactConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GptConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoggingConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LintConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LintRule); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LintAllowlist); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LintVote); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LintLabel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MegaConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConfigResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtifactTrigger); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildTrigger); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CodeTrigger); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeTrigger); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToolchainTrigger); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EnvVariable); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoggingTrigger); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewTrigger); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SshConfig); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TriggerResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArtifactInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BuildInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CodeInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MailInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ToolchainInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoggingInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepoInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ReviewInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeReport); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CpuStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DockerStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*HostStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CpuTime); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskPartition); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiskUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CgroupDockerStat); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CgroupMemDocker); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadAvg); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LoadMisc); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemSwapDevice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemSwapMemory); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MemVirtual); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetIo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NetInterface); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessMemoryInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_insight_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProcessRlimit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_insight_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   62,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_insight_proto_goTypes,
+		DependencyIndexes: file_insight_proto_depIdxs,
+		MessageInfos:      file_insight_proto_msgTypes,
+	}.Build()
+	File_insight_proto = out.File
+	file_insight_proto_rawDesc = nil
+	file_insight_proto_goTypes = nil
+	file_insight_proto_depIdxs = nil
+}
@@ -13,15 +13,19 @@ type Config struct {
 
 type BuildConfig struct {
 	LoggingConfig LoggingConfig `json:"loggingConfig"`
+	Duration      string        `json:"duration"`
 }
 
 type CodeConfig struct {
 	Duration    string       `json:"duration"`
 	LintConfigs []LintConfig `json:"lintConfigs"`
 	LintVote    LintVote     `json:"lintVote"`
+	MegaConfig  MegaConfig   `json:"megaConfig"`
 }
 
-type NodeConfig struct{}
+type NodeConfig struct {
+	Duration string `json:"duration"`
+}
 
 type ToolchainConfig struct{}
 
@@ -56,17 +60,60 @@ type LoggingConfig struct {
 }
 
 type LintConfig struct {
-	Name       string   `json:"name"`
-	Extensions []string `json:"extensions"`
-	Files      []string `json:"files"`
-	Projects   []string `json:"projects"`
+	Name       string     `json:"name"`
+	Extensions []string   `json:"extensions"`
+	Files      []string   `json:"files"`
+	Projects   []string   `json:"projects"`
+	Rules      []LintRule `json:"rules"`
+}
+
+type LintRule struct {
+	Name           string        `json:"name"`
+	Disabled       bool          `json:"disabled"`
+	Extensions     []string      `json:"extensions"`
+	Files          []string      `json:"files"`
+	SubjectMin     int64         `json:"subjectMin"`
+	SubjectMax     int64         `json:"subjectMax"`
+	DescriptionMax int64         `json:"descriptionMax"`
+	SubjectStyle   string        `json:"subjectStyle"`
+	Trailers       []string      `json:"trailers"`
+	Allowlist      LintAllowlist `json:"allowlist"`
+	License        string        `json:"license"`
+	Copyright      string        `json:"copyright"`
+	Header         string        `json:"header"`
+	SizeMax        int64         `json:"sizeMax"`
+	Denylist       []string      `json:"denylist"`
+	IllegalChars   string        `json:"illegalChars"`
+	Prompt         string        `json:"prompt"`
+	Tokens         int64         `json:"tokens"`
+}
+
+type LintAllowlist struct {
+	Paths   []string `json:"paths"`
+	Regexes []string `json:"regexes"`
 }
 
 type LintVote struct {
+	Approval    string      `json:"approval"`
+	Disapproval string      `json:"disapproval"`
+	Label       string      `json:"label"`
+	Message     string      `json:"message"`
+	Labels      []LintLabel `json:"labels"`
+	CommentOnly bool        `json:"commentOnly"`
+}
+
+type MegaConfig struct {
+	Registry   string `json:"registry"`
+	Image      string `json:"image"`
+	Tag        string `json:"tag"`
+	Digest     string `json:"digest"`
+	PullPolicy string `json:"pullPolicy"`
+}
+
+type LintLabel struct {
+	Name        string `json:"name"`
 	Approval    string `json:"approval"`
 	Disapproval string `json:"disapproval"`
-	Label       string `json:"label"`
-	Message     string `json:"message"`
 }
 
 type ConfigResponse struct{}
@@ -102,6 +149,9 @@ type EnvVariable struct {
 	Value string `json:"value"`
 }
 
+// LoggingTrigger sets the window of logging lines scanned by buildsight: Start is the line to
+// start (>=1) and Len is the lines of window (0: till the end), not the lines per region of
+// LoggingConfig.Len.
 type LoggingTrigger struct {
 	Lines []string `json:"lines"`
 	Start int64    `json:"start"`
@@ -158,10 +208,14 @@ type BuildInfo struct {
 	LoggingInfos []LoggingInfo `json:"loggingInfos"`
 	RepoInfos    []RepoInfo    `json:"repoInfos"`
 	ReviewInfos  []ReviewInfo  `json:"reviewInfos"`
+	RootCause    string        `json:"rootCause"`
+	Suggestion   string        `json:"suggestion"`
 	Error        string        `json:"error"`
 }
 
-type CodeInfo struct{}
+type CodeInfo struct {
+	Error string `json:"error"`
+}
 
 type MailInfo struct {
 	ContentType string   `json:"contentType"`
@@ -38,6 +38,7 @@ type Repo interface {
 	Deinit(context.Context) error
 	Fetch(context.Context, string, string, string) ([]byte, error)
 	Get(context.Context, string, string) (map[string]interface{}, error)
+	List(context.Context, string, string, string) ([]string, error)
 	Query(context.Context, string, string) (map[string]interface{}, error)
 }
 
@@ -92,7 +93,7 @@ func (r *repo) Deinit(ctx context.Context) error {
 // commit:COMMIT: https://android.googlesource.com/platform/build/soong/+/25900543331a1508110da4926ca45557b4c236da/README.md
 //
 // tag:TAG: https://android.googlesource.com/platform/build/soong/+/refs/heads/android14-release/README.md
-func (r *repo) Fetch(_ context.Context, project, file, operator string) ([]byte, error) {
+func (r *repo) Fetch(ctx context.Context, project, file, operator string) ([]byte, error) {
 	r.cfg.Logger.Debug("repo: Fetch")
 
 	var buf []byte
@@ -104,13 +105,13 @@ func (r *repo) Fetch(_ context.Context, project, file, operator string) ([]byte,
 
 	if strings.HasPrefix(operator, opBranch) {
 		branch := strings.TrimPrefix(operator, opBranch)
-		buf, err = r.get(r.url+"/"+url.PathEscape(project)+urlConcat+urlHeads+branch+"/"+file+"?"+urlText, r.user, r.pass)
+		buf, err = r.get(ctx, r.url+"/"+url.PathEscape(project)+urlConcat+urlHeads+branch+"/"+file+"?"+urlText, r.user, r.pass)
 	} else if strings.HasPrefix(operator, opCommit) {
 		commit := strings.TrimPrefix(operator, opCommit)
-		buf, err = r.get(r.url+"/"+url.PathEscape(project)+urlConcat+commit+"/"+file+"?"+urlText, r.user, r.pass)
+		buf, err = r.get(ctx, r.url+"/"+url.PathEscape(project)+urlConcat+commit+"/"+file+"?"+urlText, r.user, r.pass)
 	} else if strings.HasPrefix(operator, opTag) {
 		tag := strings.TrimPrefix(operator, opTag)
-		buf, err = r.get(r.url+"/"+url.PathEscape(project)+urlConcat+urlHeads+tag+"/"+file+"?"+urlText, r.user, r.pass)
+		buf, err = r.get(ctx, r.url+"/"+url.PathEscape(project)+urlConcat+urlHeads+tag+"/"+file+"?"+urlText, r.user, r.pass)
 	} else {
 		err = errors.New("operator invalid")
 	}
@@ -139,7 +140,7 @@ func (r *repo) Fetch(_ context.Context, project, file, operator string) ([]byte,
 // tag:TAG: https://android.googlesource.com/platform/build/soong/+/refs/tags/android-vts-10.0_r4?format=JSON
 //
 // nolint: lll
-func (r *repo) Get(_ context.Context, project, operator string) (map[string]interface{}, error) {
+func (r *repo) Get(ctx context.Context, project, operator string) (map[string]interface{}, error) {
 	r.cfg.Logger.Debug("repo: Get")
 
 	var body []byte
@@ -152,13 +153,13 @@ func (r *repo) Get(_ context.Context, project, operator string) (map[string]inte
 
 	if strings.HasPrefix(operator, opBranch) {
 		branch := strings.TrimPrefix(operator, opBranch)
-		body, err = r.get(r.url+"/"+url.PathEscape(project)+urlConcat+urlHeads+branch+"?"+urlJSON, r.user, r.pass)
+		body, err = r.get(ctx, r.url+"/"+url.PathEscape(project)+urlConcat+urlHeads+branch+"?"+urlJSON, r.user, r.pass)
 	} else if strings.HasPrefix(operator, opCommit) {
 		commit := strings.TrimPrefix(operator, opCommit)
-		body, err = r.get(r.url+"/"+url.PathEscape(project)+urlConcat+commit+"?"+urlJSON, r.user, r.pass)
+		body, err = r.get(ctx, r.url+"/"+url.PathEscape(project)+urlConcat+commit+"?"+urlJSON, r.user, r.pass)
 	} else if strings.HasPrefix(operator, opTag) {
 		tag := strings.TrimPrefix(operator, opTag)
-		body, err = r.get(r.url+"/"+url.PathEscape(project)+urlConcat+urlTags+tag+"?"+urlJSON, r.user, r.pass)
+		body, err = r.get(ctx, r.url+"/"+url.PathEscape(project)+urlConcat+urlTags+tag+"?"+urlJSON, r.user, r.pass)
 	} else {
 		err = errors.New("operator invalid")
 	}
@@ -176,6 +177,68 @@ func (r *repo) Get(_ context.Context, project, operator string) (map[string]inte
 	return buf, nil
 }
 
+// List
+//
+// Example:
+//
+// branch:BRANCH: https://android.googlesource.com/platform/build/soong/+/refs/heads/main/cc?format=JSON
+//
+// commit:COMMIT: https://android.googlesource.com/platform/build/soong/+/25900543331a1508110da4926ca45557b4c236da/cc?format=JSON
+//
+// tag:TAG: https://android.googlesource.com/platform/build/soong/+/refs/tags/android14-release/cc?format=JSON
+//
+// nolint: lll
+func (r *repo) List(ctx context.Context, project, dir, operator string) ([]string, error) {
+	r.cfg.Logger.Debug("repo: List")
+
+	var body []byte
+	var err error
+
+	if project == "" || operator == "" || len(strings.Split(operator, opDelimiter)) >= opGroups {
+		return nil, errors.New("parameter invalid")
+	}
+
+	// The root tree is listed with the trailing slash, the commit is returned without it.
+	dir = "/" + strings.Trim(dir, "/")
+
+	if strings.HasPrefix(operator, opBranch) {
+		branch := strings.TrimPrefix(operator, opBranch)
+		body, err = r.get(ctx, r.url+"/"+url.PathEscape(project)+urlConcat+urlHeads+branch+dir+"?"+urlJSON, r.user, r.pass)
+	} else if strings.HasPrefix(operator, opCommit) {
+		commit := strings.TrimPrefix(operator, opCommit)
+		body, err = r.get(ctx, r.url+"/"+url.PathEscape(project)+urlConcat+commit+dir+"?"+urlJSON, r.user, r.pass)
+	} else if strings.HasPrefix(operator, opTag) {
+		tag := strings.TrimPrefix(operator, opTag)
+		body, err = r.get(ctx, r.url+"/"+url.PathEscape(project)+urlConcat+urlTags+tag+dir+"?"+urlJSON, r.user, r.pass)
+	} else {
+		err = errors.New("operator invalid")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	body = []byte(strings.ReplaceAll(string(body), ")]}'", ""))
+
+	var buf struct {
+		Entries []struct {
+			Name string `json:"name"`
+		} `json:"entries"`
+	}
+
+	if err := json.Unmarshal(body, &buf); err != nil {
+		return nil, errors.Wrap(err, "unmarshal failed")
+	}
+
+	ret := make([]string, 0, len(buf.Entries))
+
+	for _, item := range buf.Entries {
+		ret = append(ret, item.Name)
+	}
+
+	return ret, nil
+}
+
 // Query
 //
 // Example:
@@ -189,7 +252,7 @@ func (r *repo) Get(_ context.Context, project, operator string) (map[string]inte
 // tag:TAG commit:COMMIT: https://android.googlesource.com/platform/build/soong/+log/refs/tags/android-vts-10.0_r4/?s=9863d53618714a36c3f254d949497a7eb2d11863&format=JSON
 //
 // nolint: gocyclo,lll
-func (r *repo) Query(_ context.Context, project, operator string) (map[string]interface{}, error) {
+func (r *repo) Query(ctx context.Context, project, operator string) (map[string]interface{}, error) {
 	r.cfg.Logger.Debug("repo: Query")
 
 	parser := func(op string) (string, string, string, error) {
@@ -238,15 +301,15 @@ func (r *repo) Query(_ context.Context, project, operator string) (map[string]in
 
 	if branch != "" {
 		if commit != "" {
-			body, err = r.get(r.url+"/"+url.PathEscape(project)+urlLog+urlHeads+branch+urlSearch+commit+"&"+urlJSON, r.user, r.pass)
+			body, err = r.get(ctx, r.url+"/"+url.PathEscape(project)+urlLog+urlHeads+branch+urlSearch+commit+"&"+urlJSON, r.user, r.pass)
 		} else {
-			body, err = r.get(r.url+"/"+url.PathEscape(project)+urlLog+urlHeads+branch+"?"+urlJSON, r.user, r.pass)
+			body, err = r.get(ctx, r.url+"/"+url.PathEscape(project)+urlLog+urlHeads+branch+"?"+urlJSON, r.user, r.pass)
 		}
 	} else if tag != "" {
 		if commit != "" {
-			body, err = r.get(r.url+"/"+url.PathEscape(project)+urlLog+urlTags+tag+urlSearch+commit+"&"+urlJSON, r.user, r.pass)
+			body, err = r.get(ctx, r.url+"/"+url.PathEscape(project)+urlLog+urlTags+tag+urlSearch+commit+"&"+urlJSON, r.user, r.pass)
 		} else {
-			body, err = r.get(r.url+"/"+url.PathEscape(project)+urlLog+urlTags+tag+"?"+urlJSON, r.user, r.pass)
+			body, err = r.get(ctx, r.url+"/"+url.PathEscape(project)+urlLog+urlTags+tag+"?"+urlJSON, r.user, r.pass)
 		}
 	} else {
 		err = errors.New("operator invalid")
@@ -265,10 +328,10 @@ func (r *repo) Query(_ context.Context, project, operator string) (map[string]in
 	return buf, nil
 }
 
-func (r *repo) get(_url, user, pass string) ([]byte, error) {
+func (r *repo) get(ctx context.Context, _url, user, pass string) ([]byte, error) {
 	r.cfg.Logger.Debug("repo: get")
 
-	req, err := http.NewRequest(http.MethodGet, _url, http.NoBody)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, _url, http.NoBody)
 	if err != nil {
 		return nil, errors.Wrap(err, "request failed")
 	}
@@ -70,6 +70,18 @@ func TestGet(t *testing.T) {
 	assert.Equal(t, nil, err)
 }
 
+func TestList(t *testing.T) {
+	ctx := context.Background()
+	r := initRepo()
+
+	ret, err := r.List(ctx, "platform/build/soong", "", "branch:master")
+	assert.Equal(t, nil, err)
+	assert.Contains(t, ret, "Android.bp")
+
+	_, err = r.List(ctx, "platform/build/soong", "cc", "commit:42ada5cff3fca011b5a0d017955f14dc63898807")
+	assert.Equal(t, nil, err)
+}
+
 func TestQuery(t *testing.T) {
 	ctx := context.Background()
 	r := initRepo()
@@ -19,12 +19,7 @@ import (
 	"github.com/reviewdog/reviewdog/diff"
 
 	"github.com/devops-pipeflow/insight-plugin/config"
-)
-
-const (
-	TypeError = "Error"
-	TypeInfo  = "Info"
-	TypeWarn  = "Warn"
+	"github.com/devops-pipeflow/insight-plugin/linters"
 )
 
 const (
@@ -45,11 +40,16 @@ const (
 	urlStart     = "&start="
 )
 
+// The files fetched are in base64 and named after the files in the change with Base64Content,
+// the commit message (CommitMessage in review) is fetched as Base64Message.
+const (
+	Base64Content = ".base64"
+	Base64Message = "message.base64"
+	CommitMessage = "/COMMIT_MSG"
+)
+
 const (
-	base64Content = ".base64"
-	base64Message = "message.base64"
-	commitMsg     = "/COMMIT_MSG"
-	commitQuery   = "commit"
+	commitQuery = "commit"
 )
 
 const (
@@ -67,6 +67,7 @@ const (
 
 var (
 	queryOptions = []string{
+		"CURRENT_COMMIT",
 		"CURRENT_FILES",
 		"CURRENT_REVISION",
 		"DETAILED_ACCOUNTS",
@@ -79,8 +80,9 @@ type Review interface {
 	Clean(context.Context, string) error
 	Diff(context.Context, int, string) (map[string]interface{}, error)
 	Fetch(context.Context, string, string) (string, string, []string, error)
+	Patch(context.Context, string) ([]byte, error)
 	Query(context.Context, string, int) ([]interface{}, error)
-	Vote(context.Context, string, []Format) error
+	Vote(context.Context, string, []linters.Finding) error
 }
 
 type Config struct {
@@ -88,13 +90,6 @@ type Config struct {
 	Logger hclog.Logger
 }
 
-type Format struct {
-	File    string
-	Line    int
-	Type    string
-	Details string
-}
-
 type review struct {
 	cfg  *Config
 	user string
@@ -220,9 +215,9 @@ func (r *review) Fetch(ctx context.Context, root, commit string) (path, name str
 			return "", "", nil, errors.Wrap(err, "failed to content")
 		}
 
-		file := filepath.Base(key) + base64Content
-		if key == commitMsg {
-			file = base64Message
+		file := filepath.Base(key) + Base64Content
+		if key == CommitMessage {
+			file = Base64Message
 		}
 
 		err = r.write(filepath.Join(path, filepath.Dir(key)), file, string(buf))
@@ -233,16 +228,37 @@ func (r *review) Fetch(ctx context.Context, root, commit string) (path, name str
 
 	// Return files
 	for key := range fs {
-		if key == commitMsg {
-			files = append(files, base64Message)
+		if key == CommitMessage {
+			files = append(files, Base64Message)
 		} else {
-			files = append(files, filepath.Join(filepath.Dir(key), filepath.Base(key)+base64Content))
+			files = append(files, filepath.Join(filepath.Dir(key), filepath.Base(key)+Base64Content))
 		}
 	}
 
 	return path, queryRet[0].(map[string]interface{})["project"].(string), files, nil
 }
 
+// Patch returns the diff of the current revision of commit, the binary files are excluded.
+func (r *review) Patch(ctx context.Context, commit string) ([]byte, error) {
+	r.cfg.Logger.Debug("review: Patch")
+	r.cfg.Logger.Debug("review: Patch: commit: " + commit)
+
+	buf, err := r.get(ctx, r.urlQuery(commitQuery+":"+commit, []string{"CURRENT_REVISION"}, 0))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query")
+	}
+
+	c, err := r.unmarshalList(buf)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshalList")
+	}
+
+	revisions := c[0].(map[string]interface{})["revisions"].(map[string]interface{})
+	current := revisions[c[0].(map[string]interface{})["current_revision"].(string)].(map[string]interface{})
+
+	return r.patch(ctx, int(c[0].(map[string]interface{})["_number"].(float64)), int(current["_number"].(float64)))
+}
+
 func (r *review) Query(ctx context.Context, search string, start int) ([]interface{}, error) {
 	r.cfg.Logger.Debug("review: Query")
 	r.cfg.Logger.Debug("review: Query: search: " + search)
@@ -282,11 +298,11 @@ func (r *review) Query(ctx context.Context, search string, start int) ([]interfa
 }
 
 // nolint:funlen,gocyclo
-func (r *review) Vote(ctx context.Context, commit string, data []Format) error {
+func (r *review) Vote(ctx context.Context, commit string, data []linters.Finding) error {
 	r.cfg.Logger.Debug("review: Vote")
 	r.cfg.Logger.Debug("review: Vote: commit: " + commit)
 
-	match := func(data Format, diffs []*diff.FileDiff) bool {
+	match := func(data linters.Finding, diffs []*diff.FileDiff) bool {
 		for _, d := range diffs {
 			if strings.Replace(d.PathNew, pathPrefix, "", 1) != data.File {
 				continue
@@ -305,20 +321,21 @@ func (r *review) Vote(ctx context.Context, commit string, data []Format) error {
 		return false
 	}
 
-	build := func(data []Format, diffs []*diff.FileDiff) (map[string]interface{}, map[string]interface{}, string) {
+	build := func(data []linters.Finding, diffs []*diff.FileDiff) (map[string]interface{}, map[string]interface{}, string) {
 		if len(data) == 0 {
-			return nil, map[string]interface{}{voteLabel: voteApproval}, voteMessage
+			labels, message := r.buildVote(true)
+			return nil, labels, message
 		}
 		c := map[string]interface{}{}
 		for _, item := range data {
-			if item.Details == "" || (item.File != commitMsg && !match(item, diffs)) {
+			if item.Message == "" || (item.File != CommitMessage && !match(item, diffs)) {
 				continue
 			}
 			l := item.Line
 			if l <= 0 {
 				l = 1
 			}
-			b := map[string]interface{}{"line": l, "message": item.Details, "unresolved": true}
+			b := map[string]interface{}{"line": l, "message": buildComment(&item), "unresolved": true}
 			if _, ok := c[item.File]; !ok {
 				c[item.File] = []map[string]interface{}{b}
 			} else {
@@ -326,9 +343,11 @@ func (r *review) Vote(ctx context.Context, commit string, data []Format) error {
 			}
 		}
 		if len(c) == 0 {
-			return nil, map[string]interface{}{voteLabel: voteApproval}, voteMessage
+			labels, message := r.buildVote(true)
+			return nil, labels, message
 		} else {
-			return c, map[string]interface{}{voteLabel: voteDisapproval}, voteMessage
+			labels, message := r.buildVote(false)
+			return c, labels, message
 		}
 	}
 
@@ -347,44 +366,130 @@ func (r *review) Vote(ctx context.Context, commit string, data []Format) error {
 	current := revisions[c[0].(map[string]interface{})["current_revision"].(string)].(map[string]interface{})
 
 	// Get patch
-	ret, err = r.get(ctx, r.urlPatch(int(c[0].(map[string]interface{})["_number"].(float64)), int(current["_number"].(float64))))
+	b, err := r.patch(ctx, int(c[0].(map[string]interface{})["_number"].(float64)), int(current["_number"].(float64)))
 	if err != nil {
 		return errors.Wrap(err, "failed to patch")
 	}
 
 	// Parse diff
+	diffs, err := diff.ParseMultiFile(bytes.NewReader(b))
+	if err != nil {
+		return errors.Wrap(err, "failed to parse")
+	}
+
+	// Review commit
+	comments, labels, message := build(data, diffs)
+	buf := map[string]interface{}{"comments": comments, "message": message}
+	if len(labels) != 0 {
+		buf["labels"] = labels
+	}
+	if err := r.post(ctx, r.urlReview(int(c[0].(map[string]interface{})["_number"].(float64)),
+		int(current["_number"].(float64))), buf); err != nil {
+		return errors.Wrap(err, "failed to review")
+	}
+
+	return nil
+}
+
+// patch returns the diff of the revision, the binary files are excluded.
+func (r *review) patch(ctx context.Context, change, revision int) ([]byte, error) {
+	r.cfg.Logger.Debug("review: patch")
+
+	ret, err := r.get(ctx, r.urlPatch(change, revision))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get")
+	}
+
 	dec := make([]byte, base64.StdEncoding.DecodedLen(len(ret)))
-	if _, err = base64.StdEncoding.Decode(dec, ret); err != nil {
-		return errors.Wrap(err, "failed to decode")
+	n, err := base64.StdEncoding.Decode(dec, ret)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode")
 	}
 
+	dec = dec[:n]
+
 	index := bytes.Index(dec, []byte(diffSep))
 	if index < 0 {
-		return errors.New("failed to index")
+		return nil, errors.New("failed to index")
 	}
 
-	var b []byte
+	var buf []byte
 
 	for _, item := range bytes.SplitAfter(dec[index:], []byte(diffSep)) {
 		if !bytes.Contains(item, []byte(diffBin)) {
-			b = bytes.Join([][]byte{b, item}, []byte(""))
+			buf = bytes.Join([][]byte{buf, item}, []byte(""))
 		}
 	}
 
-	diffs, err := diff.ParseMultiFile(bytes.NewReader(b))
-	if err != nil {
-		return errors.Wrap(err, "failed to parse")
+	return buf, nil
+}
+
+// buildVote returns the labels voted with the configured LintVote, the label, approval and
+// disapproval fall back to Code-Review, +1 and -1. No label is voted in comment-only mode.
+func (r *review) buildVote(approved bool) (map[string]interface{}, string) {
+	r.cfg.Logger.Debug("review: buildVote")
+
+	helper := func(data, val string) string {
+		if data != "" {
+			return data
+		}
+		return val
 	}
 
-	// Review commit
-	comments, labels, message := build(data, diffs)
-	buf := map[string]interface{}{"comments": comments, "labels": labels, "message": message}
-	if err := r.post(ctx, r.urlReview(int(c[0].(map[string]interface{})["_number"].(float64)),
-		int(current["_number"].(float64))), buf); err != nil {
-		return errors.Wrap(err, "failed to review")
+	v := r.cfg.Config.Spec.CodeConfig.LintVote
+	message := helper(v.Message, voteMessage)
+
+	if v.CommentOnly {
+		return nil, message
 	}
 
-	return nil
+	approval := helper(v.Approval, voteApproval)
+	disapproval := helper(v.Disapproval, voteDisapproval)
+
+	labels := v.Labels
+	if v.Label != "" || len(labels) == 0 {
+		labels = append([]config.LintLabel{{Name: helper(v.Label, voteLabel)}}, labels...)
+	}
+
+	buf := map[string]interface{}{}
+
+	for _, item := range labels {
+		if approved {
+			buf[item.Name] = helper(item.Approval, approval)
+		} else {
+			buf[item.Name] = helper(item.Disapproval, disapproval)
+		}
+	}
+
+	return buf, message
+}
+
+// buildComment returns the comment of the finding, e.g.,
+//
+// [kernellinter/trailing_whitespace] Warn: trailing whitespace
+//
+// Suggested fix:
+// int foo;
+func buildComment(data *linters.Finding) string {
+	buf := data.Message
+
+	if data.Severity != "" {
+		buf = data.Severity + ": " + buf
+	}
+
+	if data.Linter != "" {
+		name := data.Linter
+		if data.Rule != "" {
+			name += "/" + data.Rule
+		}
+		buf = "[" + name + "] " + buf
+	}
+
+	if data.Fix != "" {
+		buf += "\n\nSuggested fix:\n" + data.Fix
+	}
+
+	return buf
 }
 
 func (r *review) write(dir, file, data string) error {
@@ -531,10 +636,10 @@ func (r *review) urlReview(change, revision int) string {
 	return buf
 }
 
-func (r *review) get(_ context.Context, _url string) ([]byte, error) {
+func (r *review) get(ctx context.Context, _url string) ([]byte, error) {
 	r.cfg.Logger.Debug("review: get")
 
-	req, err := http.NewRequest(http.MethodGet, _url, http.NoBody)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, _url, http.NoBody)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to request")
 	}
@@ -564,7 +669,7 @@ func (r *review) get(_ context.Context, _url string) ([]byte, error) {
 	return data, nil
 }
 
-func (r *review) post(_ context.Context, _url string, data map[string]interface{}) error {
+func (r *review) post(ctx context.Context, _url string, data map[string]interface{}) error {
 	r.cfg.Logger.Debug("review: post")
 
 	buf, err := json.Marshal(data)
@@ -572,7 +677,7 @@ func (r *review) post(_ context.Context, _url string, data map[string]interface{
 		return errors.Wrap(err, "failed to marshal")
 	}
 
-	req, err := http.NewRequest(http.MethodPost, _url, bytes.NewBuffer(buf))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, _url, bytes.NewBuffer(buf))
 	if err != nil {
 		return errors.Wrap(err, "failed to request")
 	}
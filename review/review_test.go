@@ -22,6 +22,7 @@ import (
 	"gopkg.in/yaml.v3"
 
 	"github.com/devops-pipeflow/insight-plugin/config"
+	"github.com/devops-pipeflow/insight-plugin/linters"
 )
 
 const (
@@ -99,6 +100,15 @@ func TestFetch(t *testing.T) {
 	assert.Equal(t, nil, err)
 }
 
+func TestPatch(t *testing.T) {
+	ctx := context.Background()
+	r := initReview()
+
+	buf, err := r.Patch(ctx, commitReview)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, true, strings.HasPrefix(string(buf), diffSep))
+}
+
 // nolint: dogsled
 func TestQuery(t *testing.T) {
 	var buf []interface{}
@@ -125,7 +135,7 @@ func TestVote(t *testing.T) {
 	ctx := context.Background()
 	r := initReview()
 
-	buf := make([]Format, 0)
+	buf := make([]linters.Finding, 0)
 
 	err := r.Vote(ctx, "", buf)
 	assert.NotEqual(t, nil, err)
@@ -133,18 +143,73 @@ func TestVote(t *testing.T) {
 	err = r.Vote(ctx, commitReview, buf)
 	assert.Equal(t, nil, err)
 
-	buf = make([]Format, 1)
-	buf[0] = Format{
-		Details: "Disapproved",
-		File:    "Android.mk",
-		Line:    1,
-		Type:    TypeError,
+	buf = make([]linters.Finding, 1)
+	buf[0] = linters.Finding{
+		Message:  "Disapproved",
+		File:     "Android.mk",
+		Line:     1,
+		Severity: linters.SeverityError,
 	}
 
 	err = r.Vote(ctx, commitReview, buf)
 	assert.Equal(t, nil, err)
 }
 
+func TestBuildVote(t *testing.T) {
+	r := initReview()
+	r.cfg.Config.Spec.CodeConfig.LintVote = config.LintVote{}
+
+	labels, message := r.buildVote(true)
+	assert.Equal(t, map[string]interface{}{voteLabel: voteApproval}, labels)
+	assert.Equal(t, voteMessage, message)
+
+	labels, _ = r.buildVote(false)
+	assert.Equal(t, map[string]interface{}{voteLabel: voteDisapproval}, labels)
+
+	r.cfg.Config.Spec.CodeConfig.LintVote = config.LintVote{
+		Approval:    "+1",
+		Disapproval: "-1",
+		Message:     "Voting by lint",
+		Labels: []config.LintLabel{
+			{Name: "Lint-Check"},
+			{Name: "Code-Review", Approval: "0", Disapproval: "-2"},
+		},
+	}
+
+	labels, message = r.buildVote(false)
+	assert.Equal(t, map[string]interface{}{"Lint-Check": "-1", "Code-Review": "-2"}, labels)
+	assert.Equal(t, "Voting by lint", message)
+
+	labels, _ = r.buildVote(true)
+	assert.Equal(t, map[string]interface{}{"Lint-Check": "+1", "Code-Review": "0"}, labels)
+
+	r.cfg.Config.Spec.CodeConfig.LintVote.Label = "Verified"
+
+	labels, _ = r.buildVote(true)
+	assert.Equal(t, 3, len(labels))
+	assert.Equal(t, "+1", labels["Verified"])
+
+	r.cfg.Config.Spec.CodeConfig.LintVote.CommentOnly = true
+
+	labels, message = r.buildVote(false)
+	assert.Equal(t, 0, len(labels))
+	assert.Equal(t, "Voting by lint", message)
+}
+
+func TestBuildComment(t *testing.T) {
+	buf := buildComment(&linters.Finding{Message: "foo"})
+	assert.Equal(t, "foo", buf)
+
+	buf = buildComment(&linters.Finding{
+		Linter:   "kernellinter",
+		Rule:     "trailing_whitespace",
+		Severity: linters.SeverityWarn,
+		Message:  "trailing whitespace",
+		Fix:      "int foo;",
+	})
+	assert.Equal(t, "[kernellinter/trailing_whitespace] Warn: trailing whitespace\n\nSuggested fix:\nint foo;", buf)
+}
+
 func TestWrite(t *testing.T) {
 	r := initReview()
 
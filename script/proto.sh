@@ -0,0 +1,9 @@
+#!/bin/bash
+
+# protoc, protoc-gen-go and protoc-gen-go-grpc are required
+# go install google.golang.org/protobuf/cmd/protoc-gen-go@v1.33.0
+# go install google.golang.org/grpc/cmd/protoc-gen-go-grpc@v1.3.0
+
+protoc -I proto --go_out=proto/pb --go_opt=paths=source_relative \
+  --go-grpc_out=proto/pb --go-grpc_opt=paths=source_relative \
+  proto/insight.proto
@@ -0,0 +1,279 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	"github.com/devops-pipeflow/insight-plugin/config"
+	"github.com/devops-pipeflow/insight-plugin/insight"
+	"github.com/devops-pipeflow/insight-plugin/proto"
+	"github.com/devops-pipeflow/insight-plugin/proto/pb"
+)
+
+type Server interface {
+	Init(context.Context) error
+	Deinit(context.Context) error
+	Run(context.Context) error
+	Config(context.Context, *proto.Config) (*proto.ConfigResponse, error)
+	Trigger(context.Context, *proto.TriggerRequest) (*proto.TriggerResponse, error)
+}
+
+type Config struct {
+	Addr    string
+	Config  config.Config
+	Logger  hclog.Logger
+	Insight insight.Insight
+	Reload  func(context.Context, *config.Config) (insight.Insight, error)
+}
+
+type server struct {
+	cfg      *Config
+	insight  insight.Insight
+	runs     *sync.WaitGroup
+	retired  sync.WaitGroup
+	listener net.Listener
+	mutex    sync.RWMutex
+	server   *grpc.Server
+}
+
+// service serves the protobuf stubs generated from proto/insight.proto, the messages are
+// converted to and from the structs in package proto by the JSON names of the fields.
+type service struct {
+	pb.UnimplementedInsightServer
+	server Server
+}
+
+func New(_ context.Context, cfg *Config) Server {
+	return &server{
+		cfg: cfg,
+	}
+}
+
+func DefaultConfig() *Config {
+	return &Config{}
+}
+
+func (s *server) Init(ctx context.Context) error {
+	s.cfg.Logger.Debug("server: Init")
+
+	var err error
+
+	if s.cfg.Insight == nil {
+		return errors.New("invalid insight")
+	}
+
+	if err = s.cfg.Insight.Init(ctx); err != nil {
+		return errors.Wrap(err, "failed to init insight")
+	}
+
+	s.insight = s.cfg.Insight
+	s.runs = &sync.WaitGroup{}
+
+	s.listener, err = net.Listen("tcp", s.cfg.Addr)
+	if err != nil {
+		_ = s.insight.Deinit(ctx)
+		return errors.Wrap(err, "failed to listen")
+	}
+
+	s.server = grpc.NewServer()
+	pb.RegisterInsightServer(s.server, &service{server: s})
+
+	s.cfg.Logger.Debug("server: addr: " + s.listener.Addr().String())
+
+	return nil
+}
+
+func (s *server) Deinit(ctx context.Context) error {
+	s.cfg.Logger.Debug("server: Deinit")
+
+	if s.server != nil {
+		s.server.GracefulStop()
+	}
+
+	s.retired.Wait()
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.insight != nil {
+		_ = s.insight.Deinit(ctx)
+	}
+
+	return nil
+}
+
+func (s *server) Run(_ context.Context) error {
+	s.cfg.Logger.Debug("server: Run")
+
+	if err := s.server.Serve(s.listener); err != nil {
+		return errors.Wrap(err, "failed to serve")
+	}
+
+	return nil
+}
+
+// Config reloads insight with the sections set in req merged into the config, the sections
+// unset are kept (see config.FromProto). The insight replaced is deinited once its runs are done.
+func (s *server) Config(ctx context.Context, req *proto.Config) (*proto.ConfigResponse, error) {
+	s.cfg.Logger.Debug("server: Config")
+
+	if req == nil {
+		return nil, errors.New("invalid request")
+	}
+
+	if s.cfg.Reload == nil {
+		return nil, errors.New("invalid reload")
+	}
+
+	cfg := s.cfg.Config
+	config.FromProto(&cfg, req)
+
+	if err := cfg.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate")
+	}
+
+	i, err := s.cfg.Reload(ctx, &cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to reload")
+	}
+
+	if err := i.Init(ctx); err != nil {
+		_ = i.Deinit(ctx)
+		return nil, errors.Wrap(err, "failed to init insight")
+	}
+
+	s.mutex.Lock()
+	old, runs := s.insight, s.runs
+	s.insight, s.runs = i, &sync.WaitGroup{}
+	s.cfg.Config = cfg
+	s.mutex.Unlock()
+
+	if old != nil {
+		s.retired.Add(1)
+		go func(ctx context.Context) {
+			defer s.retired.Done()
+			runs.Wait()
+			_ = old.Deinit(ctx)
+		}(context.WithoutCancel(ctx))
+	}
+
+	return &proto.ConfigResponse{}, nil
+}
+
+func (s *server) Trigger(ctx context.Context, req *proto.TriggerRequest) (*proto.TriggerResponse, error) {
+	s.cfg.Logger.Debug("server: Trigger")
+
+	if req == nil {
+		return nil, errors.New("invalid request")
+	}
+
+	s.mutex.RLock()
+	i, runs := s.insight, s.runs
+	runs.Add(1)
+	s.mutex.RUnlock()
+
+	defer runs.Done()
+
+	buildInfo, codeInfo, mailInfo, nodeInfo, err := i.Run(ctx, req.BuildTrigger, req.CodeTrigger, req.NodeTrigger)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to run insight")
+	}
+
+	return &proto.TriggerResponse{
+		BuildInfo: buildInfo,
+		CodeInfo:  codeInfo,
+		MailInfo:  mailInfo,
+		NodeInfo:  nodeInfo,
+	}, nil
+}
+
+func (s *service) Config(ctx context.Context, req *pb.ConfigRequest) (*pb.ConfigResponse, error) {
+	var in proto.Config
+
+	if err := fromMessage(req, &in); err != nil {
+		return nil, errors.Wrap(err, "failed to convert request")
+	}
+
+	if _, err := s.server.Config(ctx, &in); err != nil {
+		return nil, err
+	}
+
+	return &pb.ConfigResponse{}, nil
+}
+
+func (s *service) Trigger(ctx context.Context, req *pb.TriggerRequest) (*pb.TriggerResponse, error) {
+	var in proto.TriggerRequest
+
+	if err := fromMessage(req, &in); err != nil {
+		return nil, errors.Wrap(err, "failed to convert request")
+	}
+
+	rsp, err := s.server.Trigger(ctx, &in)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &pb.TriggerResponse{}
+
+	if err := toMessage(rsp, out); err != nil {
+		return nil, errors.Wrap(err, "failed to convert response")
+	}
+
+	return out, nil
+}
+
+// fromMessage converts the message into the struct in package proto, the 64-bit integers are
+// kept in numbers (protojson writes them in strings which encoding/json can not read).
+func fromMessage(m protoreflect.ProtoMessage, v any) error {
+	buf, err := json.Marshal(messageMap(m.ProtoReflect()))
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(buf, v)
+}
+
+// toMessage converts the struct in package proto into the message.
+func toMessage(v any, m protoreflect.ProtoMessage) error {
+	buf, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	return protojson.UnmarshalOptions{DiscardUnknown: true}.Unmarshal(buf, m)
+}
+
+func messageMap(m protoreflect.Message) map[string]any {
+	buf := map[string]any{}
+
+	m.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.IsList() {
+			items := make([]any, 0, v.List().Len())
+			for i := 0; i < v.List().Len(); i++ {
+				items = append(items, fieldValue(fd, v.List().Get(i)))
+			}
+			buf[fd.JSONName()] = items
+		} else {
+			buf[fd.JSONName()] = fieldValue(fd, v)
+		}
+		return true
+	})
+
+	return buf
+}
+
+func fieldValue(fd protoreflect.FieldDescriptor, v protoreflect.Value) any {
+	if fd.Kind() == protoreflect.MessageKind {
+		return messageMap(v.Message())
+	}
+
+	return v.Interface()
+}
@@ -0,0 +1,205 @@
+package server
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/devops-pipeflow/insight-plugin/config"
+	"github.com/devops-pipeflow/insight-plugin/insight"
+	"github.com/devops-pipeflow/insight-plugin/proto"
+	"github.com/devops-pipeflow/insight-plugin/proto/pb"
+)
+
+type fakeInsight struct {
+	init    int
+	deinit  int
+	initErr error
+	trigger *proto.BuildTrigger
+}
+
+func (f *fakeInsight) Init(_ context.Context) error {
+	f.init++
+	return f.initErr
+}
+
+func (f *fakeInsight) Deinit(_ context.Context) error {
+	f.deinit++
+	return nil
+}
+
+// nolint: lll
+func (f *fakeInsight) Run(_ context.Context, buildTrigger *proto.BuildTrigger, _ *proto.CodeTrigger, _ *proto.NodeTrigger) (proto.BuildInfo, proto.CodeInfo, proto.MailInfo, proto.NodeInfo, error) {
+	var buildInfo proto.BuildInfo
+	if buildTrigger != nil {
+		f.trigger = buildTrigger
+		buildInfo.Error = "build"
+		buildInfo.LoggingInfos = []proto.LoggingInfo{{File: "foo.c", StartLine: 1, EndLine: 2, Type: "error"}}
+	}
+	nodeInfo := proto.NodeInfo{
+		NodeStat: proto.NodeStat{
+			MemStat: proto.MemStat{MemVirtual: proto.MemVirtual{Total: 1 << 40}},
+			ProcessStat: proto.ProcessStat{ProcessInfos: []proto.ProcessInfo{
+				{Name: "foo", Statuses: []string{"running"}, ProcessRLimits: []proto.ProcessRLimit{{Resource: 1, Soft: 2}}},
+			}},
+		},
+	}
+	return buildInfo, proto.CodeInfo{}, proto.MailInfo{Subject: "subject"}, nodeInfo, nil
+}
+
+func initServer(i insight.Insight) *server {
+	return &server{
+		cfg: &Config{
+			Addr:   "127.0.0.1:0",
+			Config: config.Config{},
+			Logger: hclog.New(&hclog.LoggerOptions{
+				Name:  "server",
+				Level: hclog.LevelFromString("INFO"),
+			}),
+			Insight: i,
+		},
+		insight: i,
+		runs:    &sync.WaitGroup{},
+	}
+}
+
+func TestServerInitDeinit(t *testing.T) {
+	ctx := context.Background()
+	i := &fakeInsight{}
+	s := initServer(i)
+
+	err := s.Init(ctx)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, i.init)
+
+	err = s.Deinit(ctx)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, i.deinit)
+}
+
+func TestServerConfig(t *testing.T) {
+	ctx := context.Background()
+	i := &fakeInsight{}
+	s := initServer(i)
+
+	_, err := s.Config(ctx, &proto.Config{})
+	assert.NotEqual(t, nil, err)
+
+	n := &fakeInsight{}
+	s.cfg.Reload = func(_ context.Context, cfg *config.Config) (insight.Insight, error) {
+		assert.Equal(t, "127.0.0.1:8081", cfg.Spec.GptConfig.Url)
+		return n, nil
+	}
+
+	req := &proto.Config{
+		GptConfig: proto.GptConfig{
+			Url: "127.0.0.1:8081",
+		},
+	}
+
+	_, err = s.Config(ctx, &proto.Config{CodeConfig: proto.CodeConfig{Duration: "invalid"}})
+	assert.NotEqual(t, nil, err)
+	assert.Equal(t, 0, n.init)
+
+	_, err = s.Config(ctx, req)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, n.init)
+	s.retired.Wait()
+	assert.Equal(t, 1, i.deinit)
+	assert.Equal(t, "127.0.0.1:8081", s.cfg.Config.Spec.GptConfig.Url)
+
+	s.cfg.Config.Spec.ReviewConfig.Url = "127.0.0.1:8080"
+	s.cfg.Reload = func(_ context.Context, cfg *config.Config) (insight.Insight, error) {
+		return n, nil
+	}
+
+	_, err = s.Config(ctx, &proto.Config{CodeConfig: proto.CodeConfig{Duration: "10s"}})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "10s", s.cfg.Config.Spec.CodeConfig.Duration)
+	assert.Equal(t, "127.0.0.1:8081", s.cfg.Config.Spec.GptConfig.Url)
+	assert.Equal(t, "127.0.0.1:8080", s.cfg.Config.Spec.ReviewConfig.Url)
+
+	f := &fakeInsight{initErr: errors.New("init")}
+	s.cfg.Reload = func(_ context.Context, cfg *config.Config) (insight.Insight, error) {
+		return f, nil
+	}
+
+	_, err = s.Config(ctx, req)
+	assert.NotEqual(t, nil, err)
+	assert.Equal(t, 1, f.deinit)
+	assert.Equal(t, n, s.insight)
+}
+
+func TestServerTrigger(t *testing.T) {
+	ctx := context.Background()
+	s := initServer(&fakeInsight{})
+
+	_, err := s.Trigger(ctx, nil)
+	assert.NotEqual(t, nil, err)
+
+	rsp, err := s.Trigger(ctx, &proto.TriggerRequest{BuildTrigger: &proto.BuildTrigger{}})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "build", rsp.BuildInfo.Error)
+	assert.Equal(t, "subject", rsp.MailInfo.Subject)
+}
+
+func TestServerRpc(t *testing.T) {
+	ctx := context.Background()
+	i := &fakeInsight{}
+	s := initServer(i)
+
+	err := s.Init(ctx)
+	assert.Equal(t, nil, err)
+
+	go func() {
+		_ = s.Run(ctx)
+	}()
+
+	defer func() {
+		_ = s.Deinit(ctx)
+	}()
+
+	conn, err := grpc.NewClient(s.listener.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	assert.Equal(t, nil, err)
+
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	client := pb.NewInsightClient(conn)
+
+	rsp, err := client.Trigger(ctx, &pb.TriggerRequest{
+		BuildTrigger: &pb.BuildTrigger{
+			LoggingTrigger: &pb.LoggingTrigger{Lines: []string{"foo"}, Start: 1, Len: 1},
+		},
+	})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{"foo"}, i.trigger.LoggingTrigger.Lines)
+	assert.Equal(t, int64(1), i.trigger.LoggingTrigger.Len)
+	assert.Equal(t, "build", rsp.BuildInfo.Error)
+	assert.Equal(t, int64(2), rsp.BuildInfo.LoggingInfos[0].EndLine)
+	assert.Equal(t, "subject", rsp.MailInfo.Subject)
+	assert.Equal(t, uint64(1<<40), rsp.NodeInfo.NodeStat.MemStat.MemVirtual.Total)
+	assert.Equal(t, []string{"running"}, rsp.NodeInfo.NodeStat.ProcessStat.ProcessInfos[0].Statuses)
+	assert.Equal(t, uint64(2), rsp.NodeInfo.NodeStat.ProcessStat.ProcessInfos[0].ProcessRlimits[0].Soft)
+
+	s.cfg.Reload = func(_ context.Context, cfg *config.Config) (insight.Insight, error) {
+		return &fakeInsight{}, nil
+	}
+
+	_, err = client.Config(ctx, &pb.ConfigRequest{CodeConfig: &pb.CodeConfig{
+		Duration:    "10s",
+		LintConfigs: []*pb.LintConfig{{Name: "commitlinter", Rules: []*pb.LintRule{{Name: "file", SizeMax: 100}}}},
+	}})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, int64(100), s.cfg.Config.Spec.CodeConfig.LintConfigs[0].Rules[0].SizeMax)
+
+	_, err = client.Config(ctx, &pb.ConfigRequest{CodeConfig: &pb.CodeConfig{Duration: "invalid"}})
+	assert.NotEqual(t, nil, err)
+}
@@ -2,16 +2,73 @@ package sights
 
 import (
 	"context"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
 
 	"github.com/hashicorp/go-hclog"
+	"github.com/pkg/errors"
 
 	"github.com/devops-pipeflow/insight-plugin/config"
 	"github.com/devops-pipeflow/insight-plugin/gpt"
+	"github.com/devops-pipeflow/insight-plugin/mail"
 	"github.com/devops-pipeflow/insight-plugin/proto"
 	"github.com/devops-pipeflow/insight-plugin/repo"
 	"github.com/devops-pipeflow/insight-plugin/review"
 )
 
+const (
+	loggingCount = 10
+	loggingLen   = 20
+	loggingSep   = "\n"
+	loggingStart = 1
+
+	repoBranch = "branch:"
+	repoCommit = "commit:"
+	repoCount  = 20
+	repoHeads  = "refs/heads/"
+
+	reviewChange = "change:"
+	reviewTopic  = "topic:"
+
+	errorSep = "; "
+
+	gptChars   = 4
+	gptContext = 20
+	gptLineMax = 500
+	gptTokens  = 3000
+
+	mailErrors = "errors.txt"
+	mailSight  = "buildsight"
+
+	typeError = "error"
+	typeInfo  = "info"
+	typeWarn  = "warn"
+)
+
+var (
+	loggingError = regexp.MustCompile(`(?i)\b(error|errors|fatal|failed|failure|exception|panic)\b`)
+	loggingInfo  = regexp.MustCompile(`(?i)\b(build completed successfully|build successful|build succeeded)\b`)
+	loggingWarn  = regexp.MustCompile(`(?i)\b(warn|warning|warnings|deprecated)\b`)
+
+	gptFix = regexp.MustCompile(`(?im)^\s*(?:suggested\s+)?fix:\s*`)
+
+	gptPrompt = `You are a build engineer. Explain the root cause of the failed build below and suggest a fix.
+Answer in two sections: "Cause:" followed by the root cause, and "Fix:" followed by the suggested fix.
+`
+
+	loggingTypes = map[string]int{
+		typeError: 3,
+		typeWarn:  2,
+		typeInfo:  1,
+	}
+)
+
 type BuildSight interface {
 	Init(context.Context) error
 	Deinit(context.Context) error
@@ -19,15 +76,26 @@ type BuildSight interface {
 }
 
 type BuildSightConfig struct {
-	Config config.Config
-	Logger hclog.Logger
-	Gpt    gpt.Gpt
-	Repo   repo.Repo
-	Review review.Review
+	Config     config.Config
+	Logger     hclog.Logger
+	Gpt        gpt.Gpt
+	Repo       repo.Repo
+	Review     review.Review
+	Mail       mail.Mail
+	Extractors []Extractor
 }
 
 type buildsight struct {
-	cfg *BuildSightConfig
+	cfg        *BuildSightConfig
+	extractors []Extractor
+}
+
+type loggingRegion struct {
+	start int
+	file  string
+	line  int64
+	kind  string
+	lines []string
 }
 
 func BuildSightNew(_ context.Context, cfg *BuildSightConfig) BuildSight {
@@ -43,7 +111,23 @@ func DefaultBuildSightConfig() *BuildSightConfig {
 func (bs *buildsight) Init(ctx context.Context) error {
 	bs.cfg.Logger.Debug("buildsight: Init")
 
-	// TBD: FIXME
+	bs.extractors = slices.Concat(bs.cfg.Extractors, DefaultExtractors())
+
+	if err := bs.cfg.Repo.Init(ctx); err != nil {
+		return errors.Wrap(err, "failed to init repo")
+	}
+
+	if err := bs.cfg.Review.Init(ctx); err != nil {
+		return errors.Wrap(err, "failed to init review")
+	}
+
+	if err := bs.cfg.Gpt.Init(ctx); err != nil {
+		return errors.Wrap(err, "failed to init gpt")
+	}
+
+	if err := bs.cfg.Mail.Init(ctx); err != nil {
+		return errors.Wrap(err, "failed to init mail")
+	}
 
 	return nil
 }
@@ -51,7 +135,10 @@ func (bs *buildsight) Init(ctx context.Context) error {
 func (bs *buildsight) Deinit(ctx context.Context) error {
 	bs.cfg.Logger.Debug("buildsight: Deinit")
 
-	// TBD: FIXME
+	_ = bs.cfg.Mail.Deinit(ctx)
+	_ = bs.cfg.Gpt.Deinit(ctx)
+	_ = bs.cfg.Review.Deinit(ctx)
+	_ = bs.cfg.Repo.Deinit(ctx)
 
 	return nil
 }
@@ -62,7 +149,565 @@ func (bs *buildsight) Run(ctx context.Context, trigger *proto.BuildTrigger) (pro
 	var buildInfo proto.BuildInfo
 	var mailInfo proto.MailInfo
 
-	// TBD: FIXME
+	if trigger == nil {
+		return buildInfo, mailInfo, errors.New("invalid trigger")
+	}
+
+	loggingInfos, err := bs.runLogging(ctx, &trigger.LoggingTrigger)
+	if err != nil {
+		buildInfo.Error = err.Error()
+		return buildInfo, mailInfo, errors.Wrap(err, "failed to run logging")
+	}
+
+	buildInfo.LoggingInfos = loggingInfos
+
+	var errs []string
+
+	repoInfos, err := bs.runRepo(ctx, &trigger.ReviewTrigger, loggingInfos)
+	if err != nil {
+		bs.cfg.Logger.Warn("buildsight: failed to run repo: " + err.Error())
+		errs = append(errs, errors.Wrap(err, "failed to run repo").Error())
+	}
+
+	buildInfo.RepoInfos = repoInfos
+
+	reviewInfos, err := bs.runReview(ctx, &trigger.ReviewTrigger)
+	if err != nil {
+		bs.cfg.Logger.Warn("buildsight: failed to run review: " + err.Error())
+		errs = append(errs, errors.Wrap(err, "failed to run review").Error())
+	}
+
+	buildInfo.ReviewInfos = reviewInfos
+
+	cause, fix, err := bs.runGpt(ctx, &trigger.LoggingTrigger, &buildInfo)
+	if err != nil {
+		bs.cfg.Logger.Warn("buildsight: failed to run gpt: " + err.Error())
+		errs = append(errs, errors.Wrap(err, "failed to run gpt").Error())
+	}
+
+	buildInfo.RootCause = cause
+	buildInfo.Suggestion = fix
+	buildInfo.Error = strings.Join(errs, errorSep)
+
+	mailInfo, err = bs.runMail(ctx, &trigger.ReviewTrigger, &buildInfo)
+	if err != nil {
+		bs.cfg.Logger.Warn("buildsight: failed to run mail: " + err.Error())
+	}
 
 	return buildInfo, mailInfo, nil
 }
+
+// runLogging scans the logging lines and returns the failing regions found.
+//
+// The window is set by LoggingTrigger.Start (>=1) and LoggingTrigger.Len (0: till the end),
+// LoggingConfig.Start is used if LoggingTrigger.Start is not set. Each region holds at most
+// LoggingConfig.Len lines and at most LoggingConfig.Count regions are returned, errors first.
+//
+// Regions recognized by the extractors point at the source code (File and StartLine/EndLine
+// set to the source line, 0 if unknown), the others point at the logging lines (File unset).
+func (bs *buildsight) runLogging(_ context.Context, trigger *proto.LoggingTrigger) ([]proto.LoggingInfo, error) {
+	bs.cfg.Logger.Debug("buildsight: runLogging")
+
+	cfg := bs.cfg.Config.Spec.BuildConfig.LoggingConfig
+
+	start := trigger.Start
+	if start <= 0 {
+		start = cfg.Start
+	}
+
+	if start <= 0 {
+		start = loggingStart
+	}
+
+	if start > int64(len(trigger.Lines)) {
+		return nil, nil
+	}
+
+	end := int64(len(trigger.Lines))
+	if trigger.Len > 0 && start-1+trigger.Len < end {
+		end = start - 1 + trigger.Len
+	}
+
+	length := cfg.Len
+	if length <= 0 {
+		length = loggingLen
+	}
+
+	count := cfg.Count
+	if count <= 0 {
+		count = loggingCount
+	}
+
+	regions := bs.buildRegions(trigger.Lines[start-1:end], int(start))
+
+	sort.SliceStable(regions, func(i, j int) bool {
+		return loggingTypes[regions[i].kind] > loggingTypes[regions[j].kind]
+	})
+
+	buf := make([]proto.LoggingInfo, 0, len(regions))
+	found := map[string]bool{}
+
+	for _, item := range regions {
+		if int64(len(buf)) >= count {
+			break
+		}
+		lines := item.lines
+		if int64(len(lines)) > length {
+			lines = lines[:length]
+		}
+		info := proto.LoggingInfo{
+			File:      item.file,
+			StartLine: item.line,
+			EndLine:   item.line,
+			Type:      item.kind,
+			Detail:    strings.Join(lines, loggingSep),
+		}
+		if item.file == "" {
+			info.StartLine = int64(item.start)
+			info.EndLine = int64(item.start + len(lines) - 1)
+		}
+		key := strings.Join([]string{info.File, strconv.FormatInt(info.StartLine, 10), info.Type, lines[0]}, loggingSep)
+		if found[key] {
+			continue
+		}
+		found[key] = true
+		buf = append(buf, info)
+	}
+
+	return buf, nil
+}
+
+// buildRegions groups the lines into regions with the extractors, the lines not recognized are
+// classified and grouped, continuation lines (indented lines, e.g., source snippets and carets)
+// are kept in the region they follow.
+//
+// nolint: gocyclo
+func (bs *buildsight) buildRegions(lines []string, offset int) []loggingRegion {
+	var buf []loggingRegion
+	var region *loggingRegion
+
+	flush := func() {
+		if region != nil {
+			buf = append(buf, *region)
+			region = nil
+		}
+	}
+
+	for index := 0; index < len(lines); index++ {
+		if e, n := bs.runExtractors(lines, index); n > 0 {
+			flush()
+			buf = append(buf, loggingRegion{
+				start: offset + index,
+				file:  e.File,
+				line:  e.Line,
+				kind:  e.Type,
+				lines: e.Detail,
+			})
+			index += n - 1
+			continue
+		}
+		line := lines[index]
+		kind := bs.parseType(line)
+		if kind == "" {
+			if region != nil && strings.TrimSpace(line) != "" && (line[0] == ' ' || line[0] == '\t') {
+				region.lines = append(region.lines, line)
+				continue
+			}
+			flush()
+			continue
+		}
+		if region != nil && region.kind == kind {
+			region.lines = append(region.lines, line)
+			continue
+		}
+		flush()
+		region = &loggingRegion{
+			start: offset + index,
+			kind:  kind,
+			lines: []string{line},
+		}
+	}
+
+	flush()
+
+	return buf
+}
+
+func (bs *buildsight) runExtractors(lines []string, index int) (Extraction, int) {
+	for _, item := range bs.extractors {
+		if e, n := item.Extract(lines, index); n > 0 {
+			if e.Type == "" {
+				e.Type = typeError
+			}
+			if len(e.Detail) == 0 {
+				e.Detail = lines[index : index+n]
+			}
+			return e, n
+		}
+	}
+
+	return Extraction{}, 0
+}
+
+// runRepo returns the recent commits on the branch of the review trigger which touched the
+// failing files, at most repoCount commits are looked into.
+func (bs *buildsight) runRepo(ctx context.Context, trigger *proto.ReviewTrigger, infos []proto.LoggingInfo) (
+	[]proto.RepoInfo, error) {
+	bs.cfg.Logger.Debug("buildsight: runRepo")
+
+	var files []string
+
+	for _, item := range infos {
+		if item.File != "" && item.Type == typeError && !slices.Contains(files, item.File) {
+			files = append(files, item.File)
+		}
+	}
+
+	if trigger.Project == "" || trigger.Branch == "" || len(files) == 0 {
+		return nil, nil
+	}
+
+	branch := strings.TrimPrefix(trigger.Branch, repoHeads)
+
+	ret, err := bs.cfg.Repo.Query(ctx, trigger.Project, repoBranch+branch)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query")
+	}
+
+	logs, _ := ret["log"].([]interface{})
+	if len(logs) > repoCount {
+		logs = logs[:repoCount]
+	}
+
+	var buf []proto.RepoInfo
+	var detail map[string]interface{}
+
+	for _, item := range logs {
+		commit := parseString(parseMap(item)["commit"])
+		if commit == "" {
+			continue
+		}
+		detail, err = bs.cfg.Repo.Get(ctx, trigger.Project, repoCommit+commit)
+		if err != nil {
+			bs.cfg.Logger.Warn("buildsight: failed to get " + commit + ": " + err.Error())
+			continue
+		}
+		diffs, _ := detail["tree_diff"].([]interface{})
+		if !matchDiffs(diffs, files) {
+			continue
+		}
+		buf = append(buf, proto.RepoInfo{
+			Project:   trigger.Project,
+			Branch:    branch,
+			Commit:    commit,
+			Committer: parseIdent(detail["committer"]),
+			Author:    parseIdent(detail["author"]),
+			Message:   parseString(detail["message"]),
+			Date:      parseString(parseMap(detail["committer"])["time"]),
+		})
+	}
+
+	return buf, nil
+}
+
+// runReview returns the triggering change and the changes in the same topic.
+func (bs *buildsight) runReview(ctx context.Context, trigger *proto.ReviewTrigger) ([]proto.ReviewInfo, error) {
+	bs.cfg.Logger.Debug("buildsight: runReview")
+
+	if trigger.ChangeNumber == "" {
+		return nil, nil
+	}
+
+	changes, err := bs.cfg.Review.Query(ctx, reviewChange+trigger.ChangeNumber, 0)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query change")
+	}
+
+	if trigger.Topic != "" {
+		var topics []interface{}
+		topics, err = bs.cfg.Review.Query(ctx, reviewTopic+trigger.Topic, 0)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to query topic")
+		}
+		changes = append(changes, topics...)
+	}
+
+	var buf []proto.ReviewInfo
+
+	for _, item := range changes {
+		change := parseMap(item)
+		number, _ := change["_number"].(float64)
+		if number == 0 || slices.IndexFunc(buf, func(data proto.ReviewInfo) bool {
+			return data.Change == int64(number)
+		}) >= 0 {
+			continue
+		}
+		revisions := parseMap(change["revisions"])
+		commit := parseMap(parseMap(revisions[parseString(change["current_revision"])])["commit"])
+		buf = append(buf, proto.ReviewInfo{
+			Project: parseString(change["project"]),
+			Branch:  parseString(change["branch"]),
+			Change:  int64(number),
+			Owner:   parseIdent(change["owner"]),
+			Author:  parseIdent(commit["author"]),
+			Message: parseString(commit["message"]),
+			Date:    parseString(change["updated"]),
+		})
+	}
+
+	return buf, nil
+}
+
+// runGpt sends the error regions, the logging tail and the suspect commits to gpt, and
+// returns the root cause and the suggested fix.
+func (bs *buildsight) runGpt(ctx context.Context, trigger *proto.LoggingTrigger, info *proto.BuildInfo) (
+	cause, fix string, err error) {
+	bs.cfg.Logger.Debug("buildsight: runGpt")
+
+	if slices.IndexFunc(info.LoggingInfos, func(data proto.LoggingInfo) bool {
+		return data.Type == typeError
+	}) < 0 {
+		return "", "", nil
+	}
+
+	ret, err := bs.cfg.Gpt.Run(ctx, bs.buildPrompt(trigger, info))
+	if err != nil {
+		return "", "", errors.Wrap(err, "failed to run")
+	}
+
+	cause, fix = parseGpt(ret)
+
+	return cause, fix, nil
+}
+
+// buildPrompt builds the gpt prompt within the gptTokens budget (about gptChars characters per
+// token), the duplicated lines are dropped and the long lines truncated. The error regions come
+// first, then the suspect commits and the logging tail as context.
+func (bs *buildsight) buildPrompt(trigger *proto.LoggingTrigger, info *proto.BuildInfo) string {
+	var buf strings.Builder
+
+	budget := gptTokens*gptChars - len(gptPrompt)
+	found := map[string]bool{}
+
+	write := func(line string) bool {
+		line = truncateLine(line, gptLineMax)
+		if budget-len(line)-1 < 0 {
+			return false
+		}
+		budget -= len(line) + 1
+		buf.WriteString(line + loggingSep)
+		return true
+	}
+
+	writeLines := func(lines []string) bool {
+		for _, item := range lines {
+			key := strings.TrimSpace(item)
+			if key == "" || found[key] {
+				continue
+			}
+			found[key] = true
+			if !write(item) {
+				return false
+			}
+		}
+		return true
+	}
+
+	buf.WriteString(gptPrompt)
+
+	if write(loggingSep + "Errors:") {
+		for _, item := range info.LoggingInfos {
+			if item.Type != typeError {
+				continue
+			}
+			if item.File != "" {
+				_ = write(item.File + ":" + strconv.FormatInt(item.StartLine, 10))
+			}
+			if !writeLines(strings.Split(item.Detail, loggingSep)) {
+				break
+			}
+		}
+	}
+
+	if len(info.RepoInfos) != 0 && write(loggingSep+"Suspect commits:") {
+		for _, item := range info.RepoInfos {
+			subject, _, _ := strings.Cut(item.Message, loggingSep)
+			if !write(item.Commit + " " + item.Author + " " + subject) {
+				break
+			}
+		}
+	}
+
+	lines := trigger.Lines
+	if len(lines) > gptContext {
+		lines = lines[len(lines)-gptContext:]
+	}
+
+	if write(loggingSep + "Logging tail:") {
+		_ = writeLines(lines)
+	}
+
+	return buf.String()
+}
+
+// runMail composes the mail for the failed build, the change owner and the patchset uploader
+// are mailed to, the authors and committers of the suspect commits are copied to. The mail is
+// sent only if error regions are found, the errors of the others (e.g., failed to query repo)
+// are logged instead.
+func (bs *buildsight) runMail(ctx context.Context, trigger *proto.ReviewTrigger, info *proto.BuildInfo) (proto.MailInfo, error) {
+	bs.cfg.Logger.Debug("buildsight: runMail")
+
+	var excerpt strings.Builder
+
+	for _, item := range info.LoggingInfos {
+		if item.Type != typeError {
+			continue
+		}
+		if item.File != "" {
+			excerpt.WriteString(item.File + ":" + strconv.FormatInt(item.StartLine, 10) + loggingSep)
+		}
+		excerpt.WriteString(item.Detail + loggingSep + loggingSep)
+	}
+
+	if excerpt.Len() == 0 {
+		if info.Error != "" {
+			bs.cfg.Logger.Warn("buildsight: skip mail for no error regions: " + info.Error)
+		}
+		return proto.MailInfo{}, nil
+	}
+
+	title := "build failed"
+	if trigger.Project != "" {
+		title = fmt.Sprintf("build failed in %s (%s)", trigger.Project, strings.TrimPrefix(trigger.Branch, repoHeads))
+	}
+
+	to := []string{trigger.ChangeOwnerEmail, trigger.PatchsetUploaderEmail}
+	for _, item := range info.ReviewInfos {
+		to = append(to, item.Owner)
+	}
+
+	var cc []string
+	for _, item := range info.RepoInfos {
+		cc = append(cc, item.Author, item.Committer)
+	}
+
+	for _, item := range info.ReviewInfos {
+		cc = append(cc, item.Author)
+	}
+
+	content := mail.Content{
+		Sight:       mailSight,
+		Title:       title,
+		Data:        info,
+		ToAddresses: to,
+		CcAddresses: cc,
+	}
+
+	content.Attachments = map[string][]byte{mailErrors: []byte(excerpt.String())}
+
+	return bs.cfg.Mail.Run(ctx, &content)
+}
+
+func (bs *buildsight) parseType(line string) string {
+	if loggingError.MatchString(line) {
+		return typeError
+	}
+
+	if loggingWarn.MatchString(line) {
+		return typeWarn
+	}
+
+	if loggingInfo.MatchString(line) {
+		return typeInfo
+	}
+
+	return ""
+}
+
+// matchDiffs reports whether the tree diffs of a commit touched one of the files.
+func matchDiffs(diffs []interface{}, files []string) bool {
+	for _, item := range diffs {
+		m := parseMap(item)
+		for _, key := range []string{"new_path", "old_path"} {
+			p := parseString(m[key])
+			if p == "" {
+				continue
+			}
+			for _, f := range files {
+				if matchPath(p, f) {
+					return true
+				}
+			}
+		}
+	}
+
+	return false
+}
+
+// matchPath reports whether the two paths point at the same file, a relative path in the
+// logging (e.g., "./foo/bar.c", "bar.c") matches the path suffix in the repo.
+func matchPath(name, file string) bool {
+	clean := func(p string) string {
+		return "/" + strings.TrimLeft(filepath.ToSlash(filepath.Clean(p)), "/")
+	}
+
+	n := clean(name)
+	f := clean(file)
+
+	return strings.HasSuffix(n, f) || strings.HasSuffix(f, n)
+}
+
+func parseIdent(data interface{}) string {
+	m := parseMap(data)
+
+	name := parseString(m["name"])
+	email := parseString(m["email"])
+
+	if email == "" {
+		return name
+	}
+
+	return name + " <" + email + ">"
+}
+
+func parseMap(data interface{}) map[string]interface{} {
+	m, _ := data.(map[string]interface{})
+	return m
+}
+
+func parseString(data interface{}) string {
+	s, _ := data.(string)
+	return s
+}
+
+// parseGpt splits the gpt answer into the root cause and the suggested fix.
+func parseGpt(data string) (cause, fix string) {
+	data = strings.TrimSpace(data)
+
+	if loc := gptFix.FindStringIndex(data); loc != nil {
+		cause = data[:loc[0]]
+		fix = data[loc[1]:]
+	} else {
+		cause = data
+	}
+
+	cause = strings.TrimSpace(cause)
+	if len(cause) >= len("cause:") && strings.EqualFold(cause[:len("cause:")], "cause:") {
+		cause = strings.TrimSpace(cause[len("cause:"):])
+	}
+
+	return cause, strings.TrimSpace(fix)
+}
+
+// truncateLine cuts line to at most n bytes on the rune boundary.
+func truncateLine(line string, n int) string {
+	if len(line) <= n {
+		return line
+	}
+
+	for n > 0 && !utf8.RuneStart(line[n]) {
+		n--
+	}
+
+	return line[:n]
+}
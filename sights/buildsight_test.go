@@ -1,12 +1,458 @@
 package sights
 
 import (
+	"context"
+	"encoding/base64"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
 	"testing"
+	"unicode/utf8"
 
+	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/devops-pipeflow/insight-plugin/config"
+	"github.com/devops-pipeflow/insight-plugin/gpt"
+	"github.com/devops-pipeflow/insight-plugin/linters"
+	"github.com/devops-pipeflow/insight-plugin/mail"
+	"github.com/devops-pipeflow/insight-plugin/proto"
+	"github.com/devops-pipeflow/insight-plugin/repo"
+	"github.com/devops-pipeflow/insight-plugin/review"
+)
+
+var (
+	buildLines = []string{
+		"[ 10% 1/10] build foo.o",
+		"foo.c:3:5: warning: unused variable 'bar'",
+		"[ 20% 2/10] build baz.o",
+		"baz.c:7:1: error: unknown type name 'qux'",
+		"    7 | qux baz;",
+		"      | ^~~",
+		"ninja: build stopped: subcommand failed.",
+		"[100% 10/10] done",
+		"#### build completed successfully ####",
+	}
 )
 
-func TestBuildSight(t *testing.T) {
-	// TBD: FIXME
-	assert.Equal(t, nil, nil)
+type fakeGpt struct {
+	prompt string
+	ret    string
+}
+
+func (g *fakeGpt) Init(_ context.Context) error {
+	return nil
+}
+
+func (g *fakeGpt) Deinit(_ context.Context) error {
+	return nil
+}
+
+func (g *fakeGpt) Run(_ context.Context, prompt string) (string, error) {
+	g.prompt = prompt
+	return g.ret, nil
+}
+
+type fakeRepo struct {
+	logs    []interface{}
+	commits map[string]map[string]interface{}
+}
+
+func (r *fakeRepo) Init(_ context.Context) error {
+	return nil
+}
+
+func (r *fakeRepo) Deinit(_ context.Context) error {
+	return nil
+}
+
+func (r *fakeRepo) Fetch(_ context.Context, _, _, _ string) ([]byte, error) {
+	return nil, nil
+}
+
+func (r *fakeRepo) Get(_ context.Context, _, operator string) (map[string]interface{}, error) {
+	return r.commits[strings.TrimPrefix(operator, repoCommit)], nil
+}
+
+func (r *fakeRepo) List(_ context.Context, _, _, _ string) ([]string, error) {
+	return nil, nil
+}
+
+func (r *fakeRepo) Query(_ context.Context, _, _ string) (map[string]interface{}, error) {
+	return map[string]interface{}{"log": r.logs}, nil
+}
+
+type fakeReview struct {
+	changes  map[string][]interface{}
+	files    map[string]string
+	findings []linters.Finding
+	patch    []byte
+	votes    int
+}
+
+func (r *fakeReview) Init(_ context.Context) error {
+	return nil
+}
+
+func (r *fakeReview) Deinit(_ context.Context) error {
+	return nil
+}
+
+func (r *fakeReview) Clean(_ context.Context, _ string) error {
+	return nil
+}
+
+func (r *fakeReview) Diff(_ context.Context, _ int, _ string) (map[string]interface{}, error) {
+	return nil, nil
+}
+
+func (r *fakeReview) Fetch(_ context.Context, root, commit string) (path, name string, files []string, err error) {
+	path = filepath.Join(root, "1", commit)
+
+	for key, val := range r.files {
+		file := filepath.Join(filepath.Dir(key), filepath.Base(key)+".base64")
+		if key == "/COMMIT_MSG" {
+			file = "message.base64"
+		}
+		_ = os.MkdirAll(filepath.Join(path, filepath.Dir(file)), os.ModePerm)
+		if err := os.WriteFile(filepath.Join(path, file), []byte(base64.StdEncoding.EncodeToString([]byte(val))), 0o600); err != nil {
+			return "", "", nil, err
+		}
+		files = append(files, file)
+	}
+
+	sort.Strings(files)
+
+	return path, "project", files, nil
+}
+
+func (r *fakeReview) Patch(_ context.Context, _ string) ([]byte, error) {
+	return r.patch, nil
+}
+
+func (r *fakeReview) Query(_ context.Context, search string, _ int) ([]interface{}, error) {
+	return r.changes[search], nil
+}
+
+func (r *fakeReview) Vote(_ context.Context, _ string, data []linters.Finding) error {
+	r.findings = data
+	r.votes++
+	return nil
+}
+
+func initBuildSight() buildsight {
+	ctx := context.Background()
+
+	bs := buildsight{
+		cfg: DefaultBuildSightConfig(),
+	}
+
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:  "buildsight",
+		Level: hclog.LevelFromString("INFO"),
+	})
+
+	bs.cfg.Config = config.Config{}
+	bs.cfg.Logger = logger
+	bs.cfg.Gpt = gpt.New(ctx, &gpt.Config{Logger: logger})
+	bs.cfg.Repo = repo.New(ctx, &repo.Config{Logger: logger})
+	bs.cfg.Review = review.New(ctx, &review.Config{Logger: logger})
+	bs.cfg.Mail = mail.New(ctx, &mail.Config{Logger: logger})
+
+	_ = bs.Init(ctx)
+
+	return bs
+}
+
+func TestBuildSightRun(t *testing.T) {
+	ctx := context.Background()
+	bs := initBuildSight()
+	bs.cfg.Gpt = &fakeGpt{ret: "Cause: foo\nFix: bar"}
+
+	t.Setenv("TMPDIR", t.TempDir())
+
+	_, _, err := bs.Run(ctx, nil)
+	assert.NotEqual(t, nil, err)
+
+	trigger := proto.BuildTrigger{
+		LoggingTrigger: proto.LoggingTrigger{
+			Lines: buildLines,
+		},
+	}
+
+	info, mailInfo, err := bs.Run(ctx, &trigger)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 4, len(info.LoggingInfos))
+	assert.Equal(t, "foo", info.RootCause)
+	assert.Equal(t, "bar", info.Suggestion)
+	assert.Equal(t, "[buildsight]: build failed", mailInfo.Subject)
+	assert.Equal(t, true, strings.Contains(mailInfo.Body, "bar"))
+}
+
+func TestBuildSightRunMail(t *testing.T) {
+	ctx := context.Background()
+	bs := initBuildSight()
+
+	t.Setenv("TMPDIR", t.TempDir())
+
+	defer func() {
+		_ = bs.Deinit(ctx)
+	}()
+
+	trigger := proto.ReviewTrigger{
+		Project:               "foo",
+		Branch:                repoHeads + "main",
+		ChangeOwnerEmail:      "owner@example.com",
+		PatchsetUploaderEmail: "owner@example.com",
+	}
+
+	ret, err := bs.runMail(ctx, &trigger, &proto.BuildInfo{})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "", ret.Subject)
+
+	ret, err = bs.runMail(ctx, &trigger, &proto.BuildInfo{
+		LoggingInfos: []proto.LoggingInfo{{File: "foo.c", StartLine: 3, EndLine: 3, Type: typeWarn, Detail: "foo.c:3:5: warning"}},
+		Error:        "failed to run repo: timeout",
+	})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "", ret.Subject)
+
+	info := proto.BuildInfo{
+		LoggingInfos: []proto.LoggingInfo{
+			{File: "baz.c", StartLine: 7, EndLine: 7, Type: typeError, Detail: "baz.c:7:1: error: unknown type name 'qux'"},
+			{File: "foo.c", StartLine: 3, EndLine: 3, Type: typeWarn, Detail: "foo.c:3:5: warning: unused variable 'bar'"},
+		},
+		RepoInfos: []proto.RepoInfo{
+			{Commit: "c1", Author: "author <author@example.com>", Committer: "owner <owner@example.com>"},
+		},
+	}
+
+	ret, err = bs.runMail(ctx, &trigger, &info)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "[buildsight]: build failed in foo (main)", ret.Subject)
+	assert.Equal(t, []string{"owner@example.com"}, ret.ToAddresses)
+	assert.Equal(t, []string{"author@example.com"}, ret.CcAddresses)
+	assert.Equal(t, true, strings.Contains(ret.Body, "baz.c:7"))
+	assert.Equal(t, false, strings.Contains(ret.Body, "foo.c:3"))
+	assert.Equal(t, 1, len(ret.Attachments))
+	assert.Equal(t, mailErrors, filepath.Base(ret.Attachments[0]))
+}
+
+func TestBuildSightRunLogging(t *testing.T) {
+	ctx := context.Background()
+	bs := initBuildSight()
+
+	ret, err := bs.runLogging(ctx, &proto.LoggingTrigger{Lines: buildLines})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 4, len(ret))
+
+	assert.Equal(t, "baz.c", ret[0].File)
+	assert.Equal(t, int64(7), ret[0].StartLine)
+	assert.Equal(t, int64(7), ret[0].EndLine)
+	assert.Equal(t, typeError, ret[0].Type)
+	assert.Equal(t, 3, len(strings.Split(ret[0].Detail, loggingSep)))
+
+	assert.Equal(t, "", ret[1].File)
+	assert.Equal(t, int64(7), ret[1].StartLine)
+	assert.Equal(t, int64(7), ret[1].EndLine)
+	assert.Equal(t, typeError, ret[1].Type)
+
+	assert.Equal(t, "foo.c", ret[2].File)
+	assert.Equal(t, int64(3), ret[2].StartLine)
+	assert.Equal(t, typeWarn, ret[2].Type)
+
+	assert.Equal(t, typeInfo, ret[3].Type)
+	assert.Equal(t, int64(9), ret[3].StartLine)
+
+	ret, err = bs.runLogging(ctx, &proto.LoggingTrigger{Lines: buildLines, Start: 3, Len: 3})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(ret))
+	assert.Equal(t, "baz.c", ret[0].File)
+	assert.Equal(t, 2, len(strings.Split(ret[0].Detail, loggingSep)))
+
+	bs.cfg.Config.Spec.BuildConfig.LoggingConfig = config.LoggingConfig{Start: 1, Len: 2, Count: 1}
+
+	ret, err = bs.runLogging(ctx, &proto.LoggingTrigger{Lines: buildLines})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(ret))
+	assert.Equal(t, typeError, ret[0].Type)
+	assert.Equal(t, 2, len(strings.Split(ret[0].Detail, loggingSep)))
+
+	ret, err = bs.runLogging(ctx, &proto.LoggingTrigger{Lines: buildLines, Start: 100})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(ret))
+}
+
+func TestBuildSightRunRepo(t *testing.T) {
+	ctx := context.Background()
+	bs := initBuildSight()
+
+	bs.cfg.Repo = &fakeRepo{
+		logs: []interface{}{
+			map[string]interface{}{"commit": "c2"},
+			map[string]interface{}{"commit": "c1"},
+		},
+		commits: map[string]map[string]interface{}{
+			"c1": {
+				"commit":    "c1",
+				"author":    map[string]interface{}{"name": "author", "email": "author@example.com"},
+				"committer": map[string]interface{}{"name": "committer", "email": "committer@example.com", "time": "Mon Apr 01 00:00:00 2024"},
+				"message":   "Add baz",
+				"tree_diff": []interface{}{map[string]interface{}{"new_path": "src/baz.c"}},
+			},
+			"c2": {
+				"commit":    "c2",
+				"tree_diff": []interface{}{map[string]interface{}{"new_path": "src/foo.c"}},
+			},
+		},
+	}
+
+	trigger := proto.ReviewTrigger{
+		Project: "platform/build",
+		Branch:  "refs/heads/main",
+	}
+
+	infos := []proto.LoggingInfo{
+		{File: "./baz.c", StartLine: 7, Type: typeError},
+		{File: "foo.c", StartLine: 3, Type: typeWarn},
+	}
+
+	ret, err := bs.runRepo(ctx, &proto.ReviewTrigger{}, infos)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(ret))
+
+	ret, err = bs.runRepo(ctx, &trigger, infos)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(ret))
+	assert.Equal(t, "c1", ret[0].Commit)
+	assert.Equal(t, "main", ret[0].Branch)
+	assert.Equal(t, "author <author@example.com>", ret[0].Author)
+	assert.Equal(t, "committer <committer@example.com>", ret[0].Committer)
+	assert.Equal(t, "Mon Apr 01 00:00:00 2024", ret[0].Date)
+}
+
+func TestMatchPath(t *testing.T) {
+	assert.Equal(t, true, matchPath("src/baz.c", "./baz.c"))
+	assert.Equal(t, true, matchPath("src/baz.c", "/work/src/baz.c"))
+	assert.Equal(t, false, matchPath("src/foobaz.c", "baz.c"))
+}
+
+func TestBuildSightRunReview(t *testing.T) {
+	ctx := context.Background()
+	bs := initBuildSight()
+
+	change := func(number float64, project string) map[string]interface{} {
+		return map[string]interface{}{
+			"_number":          number,
+			"project":          project,
+			"branch":           "main",
+			"updated":          "2024-04-01 00:00:00.000000000",
+			"owner":            map[string]interface{}{"name": "owner", "email": "owner@example.com"},
+			"current_revision": "r1",
+			"revisions": map[string]interface{}{
+				"r1": map[string]interface{}{
+					"commit": map[string]interface{}{
+						"author":  map[string]interface{}{"name": "author", "email": "author@example.com"},
+						"message": "Add baz",
+					},
+				},
+			},
+		}
+	}
+
+	bs.cfg.Review = &fakeReview{
+		changes: map[string][]interface{}{
+			"change:1":  {change(1, "foo")},
+			"topic:baz": {change(1, "foo"), change(2, "bar")},
+		},
+	}
+
+	ret, err := bs.runReview(ctx, &proto.ReviewTrigger{})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 0, len(ret))
+
+	ret, err = bs.runReview(ctx, &proto.ReviewTrigger{ChangeNumber: "1"})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(ret))
+	assert.Equal(t, int64(1), ret[0].Change)
+	assert.Equal(t, "foo", ret[0].Project)
+	assert.Equal(t, "owner <owner@example.com>", ret[0].Owner)
+	assert.Equal(t, "author <author@example.com>", ret[0].Author)
+	assert.Equal(t, "Add baz", ret[0].Message)
+
+	ret, err = bs.runReview(ctx, &proto.ReviewTrigger{ChangeNumber: "1", Topic: "baz"})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 2, len(ret))
+	assert.Equal(t, int64(2), ret[1].Change)
+}
+
+func TestBuildSightRunGpt(t *testing.T) {
+	ctx := context.Background()
+	bs := initBuildSight()
+
+	g := &fakeGpt{ret: "Cause: qux is undefined.\nFix: include qux.h in baz.c."}
+	bs.cfg.Gpt = g
+
+	trigger := proto.LoggingTrigger{Lines: buildLines}
+
+	cause, fix, err := bs.runGpt(ctx, &trigger, &proto.BuildInfo{})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "", cause)
+	assert.Equal(t, "", fix)
+	assert.Equal(t, "", g.prompt)
+
+	info := proto.BuildInfo{
+		LoggingInfos: []proto.LoggingInfo{
+			{File: "baz.c", StartLine: 7, Type: typeError, Detail: buildLines[3]},
+		},
+		RepoInfos: []proto.RepoInfo{
+			{Commit: "c1", Author: "author", Message: "Add baz\n\nChange-Id: I0"},
+		},
+	}
+
+	cause, fix, err = bs.runGpt(ctx, &trigger, &info)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "qux is undefined.", cause)
+	assert.Equal(t, "include qux.h in baz.c.", fix)
+	assert.Equal(t, true, strings.Contains(g.prompt, "baz.c:7"))
+	assert.Equal(t, true, strings.Contains(g.prompt, "c1 author Add baz"))
+	assert.Equal(t, 1, strings.Count(g.prompt, buildLines[3]))
+}
+
+func TestBuildSightBuildPrompt(t *testing.T) {
+	bs := initBuildSight()
+
+	var lines []string
+	for i := 0; i < gptTokens; i++ {
+		lines = append(lines, strings.Repeat(strconv.Itoa(i), gptLineMax))
+	}
+
+	info := proto.BuildInfo{
+		LoggingInfos: []proto.LoggingInfo{
+			{Type: typeError, Detail: strings.Join(lines, loggingSep)},
+		},
+	}
+
+	buf := bs.buildPrompt(&proto.LoggingTrigger{Lines: lines}, &info)
+	assert.LessOrEqual(t, len(buf), gptTokens*gptChars)
+}
+
+func TestParseGpt(t *testing.T) {
+	cause, fix := parseGpt("Cause: foo\n\nSuggested fix: bar")
+	assert.Equal(t, "foo", cause)
+	assert.Equal(t, "bar", fix)
+
+	cause, fix = parseGpt("foo")
+	assert.Equal(t, "foo", cause)
+	assert.Equal(t, "", fix)
+}
+
+func TestTruncateLine(t *testing.T) {
+	assert.Equal(t, "foo", truncateLine("foo", 3))
+	assert.Equal(t, "fo", truncateLine("foo", 2))
+	assert.Equal(t, "foo", truncateLine("foo错误", 4))
+	assert.Equal(t, "foo错", truncateLine("foo错误", 6))
+	assert.Equal(t, true, utf8.ValidString(truncateLine(strings.Repeat("错", gptLineMax), gptLineMax)))
 }
@@ -2,32 +2,89 @@ package sights
 
 import (
 	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
 
 	"github.com/hashicorp/go-hclog"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
 
 	"github.com/devops-pipeflow/insight-plugin/config"
 	"github.com/devops-pipeflow/insight-plugin/gpt"
+	"github.com/devops-pipeflow/insight-plugin/linters"
+	"github.com/devops-pipeflow/insight-plugin/mail"
 	"github.com/devops-pipeflow/insight-plugin/proto"
 	"github.com/devops-pipeflow/insight-plugin/repo"
 	"github.com/devops-pipeflow/insight-plugin/review"
 )
 
+const (
+	LinterCommit  = "commitlinter"
+	LinterGpt     = "gptlinter"
+	LinterKernel  = "kernellinter"
+	LinterLicense = "licenselinter"
+	LinterMega    = "megalinter"
+	LinterSecret  = "secretlinter"
+)
+
+const (
+	codeMessage = "COMMIT_MSG"
+	codePattern = "insight-code-"
+	mailCode    = "codesight"
+	mailSarif   = "lint.sarif"
+)
+
 type CodeSight interface {
 	Init(context.Context) error
 	Deinit(context.Context) error
 	Run(context.Context, *proto.CodeTrigger) (proto.CodeInfo, proto.MailInfo, error)
 }
 
+// Linter is implemented by the linters in package linters.
+type Linter interface {
+	Init(context.Context) error
+	Deinit(context.Context) error
+	Run(context.Context, string, []string, []config.LintRule) ([]linters.Finding, error)
+}
+
+// PatchLinter is a linter reviewing the changed lines by the patch of change (e.g., gptlinter).
+type PatchLinter interface {
+	Linter
+	RunPatch(context.Context, string, []string, []config.LintRule, []byte) ([]linters.Finding, error)
+}
+
+// TreeLinter is a linter checking the change against the tree of branch (e.g., commitlinter).
+type TreeLinter interface {
+	Linter
+	RunTree(context.Context, string, []string, []config.LintRule, linters.Tree) ([]linters.Finding, error)
+}
+
 type CodeSightConfig struct {
-	Config config.Config
-	Logger hclog.Logger
-	Gpt    gpt.Gpt
-	Repo   repo.Repo
-	Review review.Review
+	Config  config.Config
+	Logger  hclog.Logger
+	Gpt     gpt.Gpt
+	Repo    repo.Repo
+	Review  review.Review
+	Mail    mail.Mail
+	Linters map[string]Linter
 }
 
 type codesight struct {
-	cfg *CodeSightConfig
+	cfg     *CodeSightConfig
+	linters map[string]Linter
+}
+
+// repoTree is the tree of branch read by repo (e.g., Gitiles).
+type repoTree struct {
+	repo    repo.Repo
+	project string
+	branch  string
 }
 
 func CodeSightNew(_ context.Context, cfg *CodeSightConfig) CodeSight {
@@ -43,7 +100,29 @@ func DefaultCodeSightConfig() *CodeSightConfig {
 func (cs *codesight) Init(ctx context.Context) error {
 	cs.cfg.Logger.Debug("codesight: Init")
 
-	// TBD: FIXME
+	if err := cs.cfg.Repo.Init(ctx); err != nil {
+		return errors.Wrap(err, "failed to init repo")
+	}
+
+	if err := cs.cfg.Review.Init(ctx); err != nil {
+		return errors.Wrap(err, "failed to init review")
+	}
+
+	if err := cs.cfg.Mail.Init(ctx); err != nil {
+		return errors.Wrap(err, "failed to init mail")
+	}
+
+	cs.linters = map[string]Linter{}
+
+	// The linter failed to init (e.g., no docker for megalinter) is disabled instead of
+	// failing codesight.
+	for key, val := range cs.cfg.Linters {
+		if err := val.Init(ctx); err != nil {
+			cs.cfg.Logger.Warn("codesight: failed to init " + key + ": " + err.Error())
+			continue
+		}
+		cs.linters[key] = val
+	}
 
 	return nil
 }
@@ -51,7 +130,13 @@ func (cs *codesight) Init(ctx context.Context) error {
 func (cs *codesight) Deinit(ctx context.Context) error {
 	cs.cfg.Logger.Debug("codesight: Deinit")
 
-	// TBD: FIXME
+	for _, item := range cs.linters {
+		_ = item.Deinit(ctx)
+	}
+
+	_ = cs.cfg.Mail.Deinit(ctx)
+	_ = cs.cfg.Review.Deinit(ctx)
+	_ = cs.cfg.Repo.Deinit(ctx)
 
 	return nil
 }
@@ -62,7 +147,287 @@ func (cs *codesight) Run(ctx context.Context, trigger *proto.CodeTrigger) (proto
 	var codeInfo proto.CodeInfo
 	var mailInfo proto.MailInfo
 
-	// TBD: FIXME
+	if trigger == nil {
+		return codeInfo, mailInfo, errors.New("invalid trigger")
+	}
+
+	commit := trigger.ReviewTrigger.PatchsetRevision
+	if commit == "" {
+		return codeInfo, mailInfo, errors.New("invalid revision")
+	}
+
+	root, err := os.MkdirTemp("", codePattern)
+	if err != nil {
+		return codeInfo, mailInfo, errors.Wrap(err, "failed to make temp")
+	}
+
+	defer func() {
+		_ = cs.cfg.Review.Clean(ctx, root)
+	}()
+
+	path, project, files, err := cs.cfg.Review.Fetch(ctx, root, commit)
+	if err != nil {
+		return codeInfo, mailInfo, errors.Wrap(err, "failed to fetch")
+	}
+
+	files, err = decodeFiles(path, files)
+	if err != nil {
+		return codeInfo, mailInfo, errors.Wrap(err, "failed to decode")
+	}
+
+	if project == "" {
+		project = trigger.ReviewTrigger.Project
+	}
+
+	// No linter selected (e.g., no LintConfigs matched) is not an approval, neither the vote.
+	selected, rules := cs.selectLinters(project, files)
+	if len(selected) == 0 {
+		cs.cfg.Logger.Warn("codesight: skip vote for no linters selected")
+		return codeInfo, mailInfo, nil
+	}
+
+	var patch []byte
+
+	// The patch is fetched for the patch linters only, which lint the whole files without it.
+	if cs.hasPatchLinter() {
+		patch, err = cs.cfg.Review.Patch(ctx, commit)
+		if err != nil {
+			cs.cfg.Logger.Warn("codesight: failed to patch: " + err.Error())
+		}
+	}
+
+	var tree linters.Tree
+
+	// The tree linters check the change against the branch which the change is based on.
+	if branch := strings.TrimPrefix(trigger.ReviewTrigger.Branch, repoHeads); branch != "" {
+		tree = &repoTree{repo: cs.cfg.Repo, project: project, branch: branch}
+	}
+
+	findings, errs := cs.runLinters(ctx, path, selected, rules, patch, tree)
+	codeInfo.Error = strings.Join(errs, errorSep)
+
+	mailInfo, err = cs.runMail(ctx, &trigger.ReviewTrigger, findings, codeInfo.Error)
+	if err != nil {
+		cs.cfg.Logger.Warn("codesight: failed to run mail: " + err.Error())
+	}
+
+	// No findings from a failed or timed out linter is not an approval, the vote is skipped
+	// and the error is reported in info and mail instead.
+	if codeInfo.Error != "" || ctx.Err() != nil {
+		cs.cfg.Logger.Warn("codesight: skip vote for failed linters")
+		return codeInfo, mailInfo, nil
+	}
+
+	if err := cs.cfg.Review.Vote(ctx, commit, findings); err != nil {
+		return codeInfo, mailInfo, errors.Wrap(err, "failed to vote")
+	}
 
 	return codeInfo, mailInfo, nil
 }
+
+// runLinters runs the selected linters concurrently with the files and the rules selected per
+// linter, the failed linters are reported in errors without dropping the findings of the others.
+// The patch is passed to the patch linters and the tree to the tree linters.
+func (cs *codesight) runLinters(ctx context.Context, path string, selected map[string][]string,
+	rules map[string][]config.LintRule, patch []byte, tree linters.Tree) ([]linters.Finding, []string) {
+	cs.cfg.Logger.Debug("codesight: runLinters")
+
+	var (
+		findings []linters.Finding
+		errs     []string
+		mutex    sync.Mutex
+	)
+
+	names := make([]string, 0, len(selected))
+	for key := range selected {
+		names = append(names, key)
+	}
+
+	sort.Strings(names)
+
+	g, ctx := errgroup.WithContext(ctx)
+	g.SetLimit(routineNum)
+
+	for _, name := range names {
+		linter, list := cs.linters[name], selected[name]
+		g.Go(func() error {
+			var ret []linters.Finding
+			var err error
+			if p, ok := linter.(PatchLinter); ok {
+				ret, err = p.RunPatch(ctx, path, list, rules[name], patch)
+			} else if t, ok := linter.(TreeLinter); ok {
+				ret, err = t.RunTree(ctx, path, list, rules[name], tree)
+			} else {
+				ret, err = linter.Run(ctx, path, list, rules[name])
+			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			if err != nil {
+				cs.cfg.Logger.Warn("codesight: failed to run " + name + ": " + err.Error())
+				errs = append(errs, name+": "+err.Error())
+			}
+			findings = append(findings, buildFindings(name, ret)...)
+			return nil
+		})
+	}
+
+	_ = g.Wait()
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		if findings[i].File != findings[j].File {
+			return findings[i].File < findings[j].File
+		}
+		return findings[i].Line < findings[j].Line
+	})
+
+	sort.Strings(errs)
+
+	return findings, errs
+}
+
+// runMail composes the mail for the lint findings with the findings attached in SARIF, the
+// change owner and the patchset uploader are mailed to.
+func (cs *codesight) runMail(ctx context.Context, trigger *proto.ReviewTrigger, findings []linters.Finding,
+	errs string) (proto.MailInfo, error) {
+	cs.cfg.Logger.Debug("codesight: runMail")
+
+	if len(findings) == 0 && errs == "" {
+		return proto.MailInfo{}, nil
+	}
+
+	sarif, err := linters.ExportSarif(findings)
+	if err != nil {
+		return proto.MailInfo{}, errors.Wrap(err, "failed to export sarif")
+	}
+
+	title := "lint findings"
+	if trigger.Project != "" {
+		title = fmt.Sprintf("lint findings in %s (%s)", trigger.Project, strings.TrimPrefix(trigger.Branch, repoHeads))
+	}
+
+	content := mail.Content{
+		Sight: mailCode,
+		Title: title,
+		Data: struct {
+			Findings []linters.Finding
+			Error    string
+		}{
+			Findings: findings,
+			Error:    errs,
+		},
+		ToAddresses: []string{trigger.ChangeOwnerEmail, trigger.PatchsetUploaderEmail},
+		Attachments: map[string][]byte{mailSarif: sarif},
+	}
+
+	return cs.cfg.Mail.Run(ctx, &content)
+}
+
+// hasPatchLinter reports whether any linter inited is a patch linter.
+func (cs *codesight) hasPatchLinter() bool {
+	for _, item := range cs.linters {
+		if _, ok := item.(PatchLinter); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// selectLinters returns the files to lint and the rules per linter, the linters not configured
+// in LintConfigs or matched no files are skipped. The rules of the LintConfigs matched by the
+// project are returned in order.
+func (cs *codesight) selectLinters(project string, files []string) (map[string][]string, map[string][]config.LintRule) {
+	cs.cfg.Logger.Debug("codesight: selectLinters")
+
+	buf := map[string][]string{}
+	rules := map[string][]config.LintRule{}
+
+	for _, item := range cs.cfg.Config.Spec.CodeConfig.LintConfigs {
+		if _, ok := cs.linters[item.Name]; !ok {
+			continue
+		}
+		if len(item.Projects) != 0 && !slices.Contains(item.Projects, project) {
+			continue
+		}
+		rules[item.Name] = append(rules[item.Name], item.Rules...)
+		for _, file := range files {
+			if !matchLint(&item, file) || slices.Contains(buf[item.Name], file) {
+				continue
+			}
+			buf[item.Name] = append(buf[item.Name], file)
+		}
+	}
+
+	return buf, rules
+}
+
+// matchLint matches the file by the extensions or the file names, all files are matched
+// if neither is configured.
+func matchLint(cfg *config.LintConfig, file string) bool {
+	if len(cfg.Extensions) == 0 && len(cfg.Files) == 0 {
+		return true
+	}
+
+	if ext := filepath.Ext(file); ext != "" && slices.Contains(cfg.Extensions, ext) {
+		return true
+	}
+
+	return slices.Contains(cfg.Files, filepath.Base(file))
+}
+
+// decodeFiles decodes the files fetched from review in base64, the commit message is
+// decoded into COMMIT_MSG.
+func decodeFiles(path string, files []string) ([]string, error) {
+	var buf []string
+
+	for _, item := range files {
+		data, err := os.ReadFile(filepath.Join(path, item))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to read")
+		}
+		dec, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(data)))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to decode")
+		}
+		name := strings.TrimSuffix(item, review.Base64Content)
+		if item == review.Base64Message {
+			name = codeMessage
+		}
+		if err := os.WriteFile(filepath.Join(path, name), dec, 0o600); err != nil {
+			return nil, errors.Wrap(err, "failed to write")
+		}
+		buf = append(buf, name)
+	}
+
+	return buf, nil
+}
+
+// buildFindings names the findings after the linter if unnamed, the commit message is
+// renamed to /COMMIT_MSG for review.
+func buildFindings(name string, data []linters.Finding) []linters.Finding {
+	buf := make([]linters.Finding, 0, len(data))
+
+	for _, item := range data {
+		if item.Linter == "" {
+			item.Linter = name
+		}
+		if item.File == codeMessage {
+			item.File = review.CommitMessage
+		}
+		buf = append(buf, item)
+	}
+
+	return buf
+}
+
+func (t *repoTree) Project() string {
+	return t.project
+}
+
+func (t *repoTree) List(ctx context.Context, project, dir string) ([]string, error) {
+	return t.repo.List(ctx, project, dir, repoBranch+t.branch)
+}
+
+func (t *repoTree) Read(ctx context.Context, project, file string) ([]byte, error) {
+	return t.repo.Fetch(ctx, project, file, repoBranch+t.branch)
+}
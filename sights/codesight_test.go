@@ -1,12 +1,261 @@
 package sights
 
 import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/hashicorp/go-hclog"
+	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
+
+	"github.com/devops-pipeflow/insight-plugin/config"
+	"github.com/devops-pipeflow/insight-plugin/gpt"
+	"github.com/devops-pipeflow/insight-plugin/linters"
+	"github.com/devops-pipeflow/insight-plugin/mail"
+	"github.com/devops-pipeflow/insight-plugin/proto"
+	"github.com/devops-pipeflow/insight-plugin/repo"
+	"github.com/devops-pipeflow/insight-plugin/review"
 )
 
-func TestCodeSight(t *testing.T) {
-	// TBD: FIXME
-	assert.Equal(t, nil, nil)
+type fakeLinter struct {
+	files []string
+	rules []config.LintRule
+	ret   []linters.Finding
+	err   error
+	fail  error
+}
+
+func (l *fakeLinter) Init(_ context.Context) error {
+	return l.err
+}
+
+func (l *fakeLinter) Deinit(_ context.Context) error {
+	return nil
+}
+
+func (l *fakeLinter) Run(_ context.Context, path string, files []string, rules []config.LintRule) ([]linters.Finding, error) {
+	l.files = files
+	l.rules = rules
+
+	for _, item := range files {
+		if _, err := os.Stat(filepath.Join(path, item)); err != nil {
+			return nil, err
+		}
+	}
+
+	return l.ret, l.fail
+}
+
+type fakePatchLinter struct {
+	fakeLinter
+	patch []byte
+}
+
+func (l *fakePatchLinter) RunPatch(ctx context.Context, path string, files []string, rules []config.LintRule,
+	patch []byte) ([]linters.Finding, error) {
+	l.patch = patch
+	return l.Run(ctx, path, files, rules)
+}
+
+type fakeTreeLinter struct {
+	fakeLinter
+	tree linters.Tree
+}
+
+func (l *fakeTreeLinter) RunTree(ctx context.Context, path string, files []string, rules []config.LintRule,
+	tree linters.Tree) ([]linters.Finding, error) {
+	l.tree = tree
+	return l.Run(ctx, path, files, rules)
+}
+
+func initCodeSight() codesight {
+	ctx := context.Background()
+
+	cs := codesight{
+		cfg: DefaultCodeSightConfig(),
+	}
+
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:  "codesight",
+		Level: hclog.LevelFromString("INFO"),
+	})
+
+	cs.cfg.Config = config.Config{}
+	cs.cfg.Logger = logger
+	cs.cfg.Gpt = gpt.New(ctx, &gpt.Config{Logger: logger})
+	cs.cfg.Repo = repo.New(ctx, &repo.Config{Logger: logger})
+	cs.cfg.Review = review.New(ctx, &review.Config{Logger: logger})
+	cs.cfg.Mail = mail.New(ctx, &mail.Config{Logger: logger})
+
+	return cs
+}
+
+func TestCodeSightInit(t *testing.T) {
+	ctx := context.Background()
+	cs := initCodeSight()
+
+	cs.cfg.Linters = map[string]Linter{
+		LinterCommit: &fakeLinter{},
+		LinterMega:   &fakeLinter{err: errors.New("no docker")},
+	}
+
+	err := cs.Init(ctx)
+	assert.Equal(t, nil, err)
+	assert.Equal(t, 1, len(cs.linters))
+
+	err = cs.Deinit(ctx)
+	assert.Equal(t, nil, err)
+}
+
+func TestCodeSightRun(t *testing.T) {
+	ctx := context.Background()
+	cs := initCodeSight()
+
+	t.Setenv("TMPDIR", t.TempDir())
+
+	r := &fakeReview{
+		files: map[string]string{
+			"/COMMIT_MSG":  "Add foo\n\nChange-Id: I0123456789\n",
+			"kernel/foo.c": "int foo;\n",
+			"README.md":    "# foo\n",
+		},
+	}
+
+	commit := &fakeLinter{ret: []linters.Finding{{File: codeMessage, Severity: linters.SeverityError, Message: "Subject too short"}}}
+	kernel := &fakeLinter{ret: []linters.Finding{{File: "kernel/foo.c", Line: 1, Severity: linters.SeverityWarn, Message: "foo: bar"}}}
+
+	cs.cfg.Review = r
+	cs.cfg.Linters = map[string]Linter{
+		LinterCommit: commit,
+		LinterKernel: kernel,
+		LinterGpt:    &fakeLinter{},
+	}
+
+	cs.cfg.Config.Spec.CodeConfig.LintConfigs = []config.LintConfig{
+		{Name: LinterCommit, Rules: []config.LintRule{{Name: "message", SubjectMin: 8}}},
+		{Name: LinterKernel, Extensions: []string{".c", ".h"}, Projects: []string{"project"}},
+		{Name: LinterGpt, Projects: []string{"other"}},
+		{Name: LinterCommit, Projects: []string{"other"}, Rules: []config.LintRule{{Name: "json", Disabled: true}}},
+		{Name: LinterCommit, Projects: []string{"project"}, Rules: []config.LintRule{{Name: "xml", Disabled: true}}},
+	}
+
+	_ = cs.Init(ctx)
+
+	_, _, err := cs.Run(ctx, nil)
+	assert.NotEqual(t, nil, err)
+
+	_, _, err = cs.Run(ctx, &proto.CodeTrigger{})
+	assert.NotEqual(t, nil, err)
+
+	info, mailInfo, err := cs.Run(ctx, &proto.CodeTrigger{ReviewTrigger: proto.ReviewTrigger{PatchsetRevision: "rev"}})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "", info.Error)
+	assert.Equal(t, 1, len(mailInfo.Attachments))
+	assert.Equal(t, true, strings.HasSuffix(mailInfo.Attachments[0], mailSarif))
+	assert.Equal(t, true, strings.Contains(mailInfo.Body, "kernel/foo.c:1 [kernellinter] Warn: foo: bar"))
+	assert.Equal(t, []string{"README.md", "kernel/foo.c", codeMessage}, commit.files)
+	assert.Equal(t, []string{"kernel/foo.c"}, kernel.files)
+	assert.Equal(t, []config.LintRule{{Name: "message", SubjectMin: 8}, {Name: "xml", Disabled: true}}, commit.rules)
+	assert.Equal(t, 0, len(kernel.rules))
+	assert.Equal(t, 2, len(r.findings))
+	assert.Equal(t, review.CommitMessage, r.findings[0].File)
+	assert.Equal(t, LinterCommit, r.findings[0].Linter)
+	assert.Equal(t, "kernel/foo.c", r.findings[1].File)
+	assert.Equal(t, 1, r.findings[1].Line)
+	assert.Equal(t, "foo: bar", r.findings[1].Message)
+	assert.Equal(t, 1, r.votes)
+
+	kernel.fail = errors.New("timeout")
+
+	info, _, err = cs.Run(ctx, &proto.CodeTrigger{ReviewTrigger: proto.ReviewTrigger{PatchsetRevision: "rev"}})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "kernellinter: timeout", info.Error)
+	assert.Equal(t, 1, r.votes)
+
+	cs.cfg.Config.Spec.CodeConfig.LintConfigs = []config.LintConfig{{Name: LinterKernel, Extensions: []string{".h"}}}
+
+	info, mailInfo, err = cs.Run(ctx, &proto.CodeTrigger{ReviewTrigger: proto.ReviewTrigger{PatchsetRevision: "rev"}})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "", info.Error)
+	assert.Equal(t, "", mailInfo.Body)
+	assert.Equal(t, 1, r.votes)
+}
+
+func TestCodeSightRunLinters(t *testing.T) {
+	ctx := context.Background()
+	cs := initCodeSight()
+
+	cs.linters = map[string]Linter{
+		LinterCommit: &fakeTreeLinter{
+			fakeLinter: fakeLinter{ret: []linters.Finding{{File: "foo.c", Line: 2, Message: "foo"}}, fail: errors.New("failed")},
+		},
+		LinterKernel: &fakeLinter{ret: []linters.Finding{{File: "foo.c", Line: 1, Message: "bar"}}},
+		LinterMega:   &fakeLinter{ret: []linters.Finding{{File: "foo.c", Line: 3, Message: "baz"}}},
+		LinterGpt:    &fakePatchLinter{},
+	}
+
+	cs.cfg.Config.Spec.CodeConfig.LintConfigs = []config.LintConfig{
+		{Name: LinterCommit},
+		{Name: LinterKernel},
+		{Name: LinterGpt},
+	}
+
+	path := t.TempDir()
+	_ = os.WriteFile(filepath.Join(path, "foo.c"), []byte("int foo;\n"), 0o600)
+
+	tree := &repoTree{repo: &fakeRepo{}, project: "project", branch: "main"}
+
+	selected, rules := cs.selectLinters("project", []string{"foo.c"})
+
+	findings, errs := cs.runLinters(ctx, path, selected, rules, []byte("patch"), tree)
+	assert.Equal(t, 2, len(findings))
+	assert.Equal(t, 1, findings[0].Line)
+	assert.Equal(t, LinterKernel, findings[0].Linter)
+	assert.Equal(t, 2, findings[1].Line)
+	assert.Equal(t, 1, len(errs))
+	assert.Equal(t, true, strings.HasPrefix(errs[0], LinterCommit))
+	assert.Equal(t, []byte("patch"), cs.linters[LinterGpt].(*fakePatchLinter).patch)
+	assert.Equal(t, tree, cs.linters[LinterCommit].(*fakeTreeLinter).tree)
+	assert.Equal(t, true, cs.hasPatchLinter())
+}
+
+func TestMatchLint(t *testing.T) {
+	assert.Equal(t, true, matchLint(&config.LintConfig{}, "foo.c"))
+	assert.Equal(t, true, matchLint(&config.LintConfig{Extensions: []string{".c"}}, "foo/foo.c"))
+	assert.Equal(t, false, matchLint(&config.LintConfig{Extensions: []string{".c"}}, "foo/foo.h"))
+	assert.Equal(t, true, matchLint(&config.LintConfig{Files: []string{"Android.bp"}}, "foo/Android.bp"))
+	assert.Equal(t, false, matchLint(&config.LintConfig{Files: []string{"Android.bp"}}, "foo/Android.mk"))
+}
+
+func TestDecodeFiles(t *testing.T) {
+	path := t.TempDir()
+
+	_, err := decodeFiles(path, []string{"invalid.base64"})
+	assert.NotEqual(t, nil, err)
+
+	_ = os.WriteFile(filepath.Join(path, review.Base64Message), []byte("Zm9v"), 0o600)
+
+	ret, err := decodeFiles(path, []string{review.Base64Message})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, []string{codeMessage}, ret)
+
+	buf, _ := os.ReadFile(filepath.Join(path, codeMessage))
+	assert.Equal(t, "foo", string(buf))
+}
+
+func TestBuildFindings(t *testing.T) {
+	ret := buildFindings(LinterKernel, nil)
+	assert.Equal(t, 0, len(ret))
+
+	ret = buildFindings(LinterKernel, []linters.Finding{
+		{Linter: LinterCommit, File: "foo.c"},
+		{File: codeMessage},
+	})
+	assert.Equal(t, 2, len(ret))
+	assert.Equal(t, LinterCommit, ret[0].Linter)
+	assert.Equal(t, LinterKernel, ret[1].Linter)
+	assert.Equal(t, review.CommitMessage, ret[1].File)
 }
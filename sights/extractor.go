@@ -0,0 +1,394 @@
+package sights
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+const (
+	extractorCargo  = "cargo"
+	extractorGcc    = "gcc"
+	extractorGo     = "go"
+	extractorGradle = "gradle"
+	extractorJava   = "java"
+	extractorMake   = "make"
+	extractorMaven  = "maven"
+	extractorNinja  = "ninja"
+	extractorPython = "python"
+	extractorSoong  = "soong"
+)
+
+// nolint: lll
+var (
+	cargoArrow    = regexp.MustCompile(`^\s*--> ([^\s:]+):(\d+):(\d+)`)
+	cargoHead     = regexp.MustCompile(`^(error|warning)(\[E\d{4}])?: (.*)$`)
+	gccHead       = regexp.MustCompile(`^([^\s:]+):(\d+):(?:(\d+):)?\s*(fatal error|error|warning):\s*(.*)$`)
+	gccNote       = regexp.MustCompile(`^(?:[^\s:]+:\d+:(?:\d+:)?\s*note:|In file included from |\s+from )`)
+	goHead        = regexp.MustCompile(`^([^\s:]+\.go):(\d+):(\d+): (.*)$`)
+	goTest        = regexp.MustCompile(`^\s*--- FAIL: (\S+)`)
+	goTestLine    = regexp.MustCompile(`^\s+([^\s:]+\.go):(\d+): (.*)$`)
+	gradleHead    = regexp.MustCompile(`^\* What went wrong:`)
+	gradleKotlin  = regexp.MustCompile(`^([ew]): (?:file://)?([^\s:]+\.kts?):(\d+):(\d+) (.*)$`)
+	javaFrame     = regexp.MustCompile(`^\s+at \S+\(([^():]+\.(?:java|kt|scala|groovy)):(\d+)\)`)
+	javaHead      = regexp.MustCompile(`^(?:Exception in thread "[^"]*" )?((?:[a-zA-Z_$][\w$]*\.)+[A-Z][\w$]*(?:Exception|Error))(?::\s*(.*))?$`)
+	makeHead      = regexp.MustCompile(`^g?make(?:\[\d+])?: \*\*\* \[(?:([^\]:]+):(\d+): )?([^\]]+)] Error \d+`)
+	makeFile      = regexp.MustCompile(`^([^\s:]+):(\d+): \*\*\* (.*)$`)
+	mavenHead     = regexp.MustCompile(`^\[(ERROR|WARNING)] (/?[^\s:\[\]]+\.(?:java|kt|scala|groovy)):\[(\d+),(\d+)] (.*)$`)
+	ninjaHead     = regexp.MustCompile(`^FAILED: (\S+)`)
+	pythonFrame   = regexp.MustCompile(`^\s+File "([^"]+)", line (\d+)`)
+	pythonHead    = regexp.MustCompile(`^Traceback \(most recent call last\):`)
+	soongHead     = regexp.MustCompile(`^error: ([^\s:]+\.(?:bp|mk)):(\d+):(\d+): (.*)$`)
+	soongInternal = regexp.MustCompile(`^internal error: (.*)$`)
+)
+
+// ExtractorFunc extracts the failure beginning at lines[index], it returns the
+// extraction and the number of lines consumed (0: not matched).
+type ExtractorFunc func(lines []string, index int) (Extraction, int)
+
+type Extractor struct {
+	Name    string
+	Extract ExtractorFunc
+}
+
+// Extraction is the failure found by an extractor, File and Line point at the source
+// code (Line is 0 if unknown), Detail holds the raw logging lines.
+type Extraction struct {
+	File   string
+	Line   int64
+	Type   string
+	Detail []string
+}
+
+// DefaultExtractors returns the builtin extractors, the more specific ones first.
+func DefaultExtractors() []Extractor {
+	return []Extractor{
+		{Name: extractorSoong, Extract: extractSoong},
+		{Name: extractorMaven, Extract: extractMaven},
+		{Name: extractorGradle, Extract: extractGradle},
+		{Name: extractorGcc, Extract: extractGcc},
+		{Name: extractorGo, Extract: extractGo},
+		{Name: extractorMake, Extract: extractMake},
+		{Name: extractorNinja, Extract: extractNinja},
+		{Name: extractorPython, Extract: extractPython},
+		{Name: extractorJava, Extract: extractJava},
+		{Name: extractorCargo, Extract: extractCargo},
+	}
+}
+
+// extractFollow returns the number of lines after lines[index] which match the condition.
+func extractFollow(lines []string, index int, cond func(string) bool) int {
+	n := 0
+
+	for i := index + 1; i < len(lines); i++ {
+		if !cond(lines[i]) {
+			break
+		}
+		n++
+	}
+
+	return n
+}
+
+func extractIndent(line string) bool {
+	return strings.TrimSpace(line) != "" && (line[0] == ' ' || line[0] == '\t')
+}
+
+func extractLine(data string) int64 {
+	n, _ := strconv.ParseInt(data, 10, 64)
+	return n
+}
+
+// Example:
+//
+// baz.c:7:1: error: unknown type name 'qux'
+//
+// build/make/core/main.mk:12: error: missing module
+func extractGcc(lines []string, index int) (Extraction, int) {
+	m := gccHead.FindStringSubmatch(lines[index])
+	if m == nil {
+		return Extraction{}, 0
+	}
+
+	kind := typeError
+	if m[4] == "warning" {
+		kind = typeWarn
+	}
+
+	n := 1 + extractFollow(lines, index, func(line string) bool {
+		return extractIndent(line) || gccNote.MatchString(line)
+	})
+
+	return Extraction{
+		File:   m[1],
+		Line:   extractLine(m[2]),
+		Type:   kind,
+		Detail: lines[index : index+n],
+	}, n
+}
+
+// Example:
+//
+// ./main.go:12:3: undefined: foo
+//
+// --- FAIL: TestFoo (0.00s)
+//
+//	foo_test.go:12: expected 1, got 2
+//
+// The errors of go build and go vet are with the column, the lines of test (t.Errorf) are
+// taken in the failed tests only, so that the logging lines of Go programs are not matched.
+func extractGo(lines []string, index int) (Extraction, int) {
+	if m := goTest.FindStringSubmatch(lines[index]); m != nil {
+		n := 1 + extractFollow(lines, index, extractIndent)
+		e := Extraction{
+			Type:   typeError,
+			Detail: lines[index : index+n],
+		}
+		for _, item := range lines[index+1 : index+n] {
+			if f := goTestLine.FindStringSubmatch(item); f != nil {
+				e.File = f[1]
+				e.Line = extractLine(f[2])
+				break
+			}
+		}
+		return e, n
+	}
+
+	m := goHead.FindStringSubmatch(lines[index])
+	if m == nil {
+		return Extraction{}, 0
+	}
+
+	return Extraction{
+		File:   m[1],
+		Line:   extractLine(m[2]),
+		Type:   typeError,
+		Detail: lines[index : index+1],
+	}, 1
+}
+
+// Example:
+//
+// [ERROR] /src/main/java/Foo.java:[12,5] cannot find symbol
+func extractMaven(lines []string, index int) (Extraction, int) {
+	m := mavenHead.FindStringSubmatch(lines[index])
+	if m == nil {
+		return Extraction{}, 0
+	}
+
+	kind := typeError
+	if m[1] == "WARNING" {
+		kind = typeWarn
+	}
+
+	n := 1 + extractFollow(lines, index, extractIndent)
+
+	return Extraction{
+		File:   m[2],
+		Line:   extractLine(m[3]),
+		Type:   kind,
+		Detail: lines[index : index+n],
+	}, n
+}
+
+// Example:
+//
+// e: file:///src/main/kotlin/Foo.kt:12:5 Unresolved reference: bar
+//
+// * What went wrong:
+// Execution failed for task ':app:compileDebugJavaWithJavac'.
+func extractGradle(lines []string, index int) (Extraction, int) {
+	if m := gradleKotlin.FindStringSubmatch(lines[index]); m != nil {
+		kind := typeError
+		if m[1] == "w" {
+			kind = typeWarn
+		}
+		return Extraction{
+			File:   m[2],
+			Line:   extractLine(m[3]),
+			Type:   kind,
+			Detail: lines[index : index+1],
+		}, 1
+	}
+
+	if !gradleHead.MatchString(lines[index]) {
+		return Extraction{}, 0
+	}
+
+	n := 1 + extractFollow(lines, index, func(line string) bool {
+		return strings.TrimSpace(line) != "" && !strings.HasPrefix(line, "* ")
+	})
+
+	return Extraction{
+		Type:   typeError,
+		Detail: lines[index : index+n],
+	}, n
+}
+
+// Example:
+//
+// make: *** [Makefile:12: foo.o] Error 1
+//
+// Makefile:12: *** missing separator.  Stop.
+//
+// The file is the makefile of the recipe failed, the target (e.g., foo.o) is not a source file.
+func extractMake(lines []string, index int) (Extraction, int) {
+	if m := makeHead.FindStringSubmatch(lines[index]); m != nil {
+		return Extraction{File: m[1], Line: extractLine(m[2]), Type: typeError, Detail: lines[index : index+1]}, 1
+	}
+
+	if m := makeFile.FindStringSubmatch(lines[index]); m != nil {
+		return Extraction{File: m[1], Line: extractLine(m[2]), Type: typeError, Detail: lines[index : index+1]}, 1
+	}
+
+	return Extraction{}, 0
+}
+
+// Example:
+//
+// FAILED: out/soong/.intermediates/foo/foo.o
+//
+// The output failed is not a source file, the errors of the source files following are
+// extracted by the compiler extractors (e.g., gcc).
+func extractNinja(lines []string, index int) (Extraction, int) {
+	if !ninjaHead.MatchString(lines[index]) {
+		return Extraction{}, 0
+	}
+
+	return Extraction{
+		Type:   typeError,
+		Detail: lines[index : index+1],
+	}, 1
+}
+
+// Example:
+//
+// Traceback (most recent call last):
+//
+//	File "foo.py", line 12, in <module>
+//
+// ValueError: invalid literal
+func extractPython(lines []string, index int) (Extraction, int) {
+	if !pythonHead.MatchString(lines[index]) {
+		return Extraction{}, 0
+	}
+
+	n := 1 + extractFollow(lines, index, extractIndent)
+	if index+n < len(lines) && strings.TrimSpace(lines[index+n]) != "" {
+		n++
+	}
+
+	e := Extraction{
+		Type:   typeError,
+		Detail: lines[index : index+n],
+	}
+
+	for _, item := range lines[index+1 : index+n] {
+		if m := pythonFrame.FindStringSubmatch(item); m != nil {
+			e.File = m[1]
+			e.Line = extractLine(m[2])
+		}
+	}
+
+	return e, n
+}
+
+// Example:
+//
+// Exception in thread "main" java.lang.NullPointerException: foo
+//
+//	at com.example.Foo.bar(Foo.java:12)
+func extractJava(lines []string, index int) (Extraction, int) {
+	if !javaHead.MatchString(lines[index]) {
+		return Extraction{}, 0
+	}
+
+	n := 1 + extractFollow(lines, index, func(line string) bool {
+		return extractIndent(line) || strings.HasPrefix(line, "Caused by: ")
+	})
+
+	e := Extraction{
+		Type:   typeError,
+		Detail: lines[index : index+n],
+	}
+
+	for _, item := range lines[index+1 : index+n] {
+		if m := javaFrame.FindStringSubmatch(item); m != nil {
+			e.File = m[1]
+			e.Line = extractLine(m[2])
+			break
+		}
+	}
+
+	return e, n
+}
+
+// Example:
+//
+// error[E0425]: cannot find value `foo` in this scope
+//
+//	--> src/main.rs:2:5
+//
+// The diagnostics without the error code are matched only with the source location (-->),
+// so that the errors of other tools (e.g., "error: foo") are not taken as those of rustc.
+func extractCargo(lines []string, index int) (Extraction, int) {
+	m := cargoHead.FindStringSubmatch(lines[index])
+	if m == nil {
+		return Extraction{}, 0
+	}
+
+	kind := typeError
+	if m[1] == "warning" {
+		kind = typeWarn
+	}
+
+	n := 1 + extractFollow(lines, index, func(line string) bool {
+		return extractIndent(line) || strings.HasPrefix(line, "|")
+	})
+
+	e := Extraction{
+		Type:   kind,
+		Detail: lines[index : index+n],
+	}
+
+	for _, item := range lines[index+1 : index+n] {
+		if a := cargoArrow.FindStringSubmatch(item); a != nil {
+			e.File = a[1]
+			e.Line = extractLine(a[2])
+			break
+		}
+	}
+
+	if m[2] == "" && e.File == "" {
+		return Extraction{}, 0
+	}
+
+	return e, n
+}
+
+// Example:
+//
+// error: frameworks/base/Android.bp:12:1: module "foo" variant "android_arm64": depends on undefined module "bar"
+//
+// internal error: panic in GenerateBuildActions for module "foo"
+func extractSoong(lines []string, index int) (Extraction, int) {
+	if m := soongHead.FindStringSubmatch(lines[index]); m != nil {
+		n := 1 + extractFollow(lines, index, extractIndent)
+		return Extraction{
+			File:   m[1],
+			Line:   extractLine(m[2]),
+			Type:   typeError,
+			Detail: lines[index : index+n],
+		}, n
+	}
+
+	if soongInternal.MatchString(lines[index]) {
+		n := 1 + extractFollow(lines, index, extractIndent)
+		return Extraction{
+			Type:   typeError,
+			Detail: lines[index : index+n],
+		}, n
+	}
+
+	return Extraction{}, 0
+}
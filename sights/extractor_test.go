@@ -0,0 +1,183 @@
+package sights
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestExtractGcc(t *testing.T) {
+	lines := []string{
+		"In file included from foo.c:1:",
+		"baz.c:7:1: error: unknown type name 'qux'",
+		"    7 | qux baz;",
+		"      | ^~~",
+		"baz.h:2:1: note: declared here",
+		"done",
+	}
+
+	_, n := extractGcc(lines, 0)
+	assert.Equal(t, 0, n)
+
+	e, n := extractGcc(lines, 1)
+	assert.Equal(t, 4, n)
+	assert.Equal(t, "baz.c", e.File)
+	assert.Equal(t, int64(7), e.Line)
+	assert.Equal(t, typeError, e.Type)
+
+	e, n = extractGcc([]string{"Foo.java:12: warning: [deprecation] bar"}, 0)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, "Foo.java", e.File)
+	assert.Equal(t, typeWarn, e.Type)
+
+	e, n = extractGcc([]string{"build/make/core/main.mk:12: error: missing module"}, 0)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, "build/make/core/main.mk", e.File)
+	assert.Equal(t, int64(12), e.Line)
+}
+
+func TestExtractGo(t *testing.T) {
+	e, n := extractGo([]string{"./main.go:12:3: undefined: foo"}, 0)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, "./main.go", e.File)
+	assert.Equal(t, int64(12), e.Line)
+
+	lines := []string{
+		"--- FAIL: TestFoo (0.00s)",
+		"    foo_test.go:21: expected 1, got 2",
+		"FAIL",
+	}
+
+	e, n = extractGo(lines, 0)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, "foo_test.go", e.File)
+	assert.Equal(t, int64(21), e.Line)
+
+	_, n = extractGo([]string{"main.go:12: starting server"}, 0)
+	assert.Equal(t, 0, n)
+
+	_, n = extractGo([]string{"    foo_test.go:21: expected 1, got 2"}, 0)
+	assert.Equal(t, 0, n)
+}
+
+func TestExtractMaven(t *testing.T) {
+	e, n := extractMaven([]string{"[ERROR] /src/main/java/Foo.java:[12,5] cannot find symbol"}, 0)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, "/src/main/java/Foo.java", e.File)
+	assert.Equal(t, int64(12), e.Line)
+	assert.Equal(t, typeError, e.Type)
+
+	_, n = extractMaven([]string{"[ERROR] Failed to execute goal"}, 0)
+	assert.Equal(t, 0, n)
+}
+
+func TestExtractGradle(t *testing.T) {
+	e, n := extractGradle([]string{"e: file:///src/main/kotlin/Foo.kt:12:5 Unresolved reference: bar"}, 0)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, "/src/main/kotlin/Foo.kt", e.File)
+	assert.Equal(t, int64(12), e.Line)
+
+	lines := []string{
+		"* What went wrong:",
+		"Execution failed for task ':app:compileDebugJavaWithJavac'.",
+		"> Compilation failed; see the compiler error output for details.",
+		"",
+		"* Try:",
+	}
+
+	e, n = extractGradle(lines, 0)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, "", e.File)
+}
+
+func TestExtractMake(t *testing.T) {
+	e, n := extractMake([]string{"make: *** [Makefile:12: foo.o] Error 1"}, 0)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, "Makefile", e.File)
+	assert.Equal(t, int64(12), e.Line)
+
+	e, n = extractMake([]string{"make[2]: *** [foo.o] Error 2"}, 0)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, "", e.File)
+	assert.Equal(t, int64(0), e.Line)
+
+	e, n = extractMake([]string{"Makefile:3: *** missing separator.  Stop."}, 0)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, int64(3), e.Line)
+}
+
+func TestExtractNinja(t *testing.T) {
+	e, n := extractNinja([]string{"FAILED: out/soong/.intermediates/foo/foo.o"}, 0)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, "", e.File)
+	assert.Equal(t, typeError, e.Type)
+}
+
+func TestExtractPython(t *testing.T) {
+	lines := []string{
+		"Traceback (most recent call last):",
+		"  File \"main.py\", line 3, in <module>",
+		"    foo()",
+		"  File \"foo.py\", line 12, in foo",
+		"    int('x')",
+		"ValueError: invalid literal for int() with base 10: 'x'",
+		"done",
+	}
+
+	e, n := extractPython(lines, 0)
+	assert.Equal(t, 6, n)
+	assert.Equal(t, "foo.py", e.File)
+	assert.Equal(t, int64(12), e.Line)
+	assert.Equal(t, true, strings.HasPrefix(e.Detail[5], "ValueError"))
+}
+
+func TestExtractJava(t *testing.T) {
+	lines := []string{
+		"Exception in thread \"main\" java.lang.NullPointerException: foo",
+		"\tat com.example.Foo.bar(Foo.java:12)",
+		"\tat com.example.Main.main(Main.java:5)",
+		"Caused by: java.lang.IllegalStateException",
+		"\t... 2 more",
+	}
+
+	e, n := extractJava(lines, 0)
+	assert.Equal(t, 5, n)
+	assert.Equal(t, "Foo.java", e.File)
+	assert.Equal(t, int64(12), e.Line)
+}
+
+func TestExtractCargo(t *testing.T) {
+	lines := []string{
+		"error[E0425]: cannot find value `foo` in this scope",
+		" --> src/main.rs:2:5",
+		"  |",
+		"2 |     foo;",
+		"  |     ^^^ not found in this scope",
+		"",
+	}
+
+	e, n := extractCargo(lines, 0)
+	assert.Equal(t, 3, n)
+	assert.Equal(t, "src/main.rs", e.File)
+	assert.Equal(t, int64(2), e.Line)
+	assert.Equal(t, typeError, e.Type)
+
+	e, n = extractCargo([]string{"warning: unused variable: `foo`", " --> src/main.rs:3:9"}, 0)
+	assert.Equal(t, 2, n)
+	assert.Equal(t, typeWarn, e.Type)
+
+	_, n = extractCargo([]string{"error: missing module", "warning: [deprecation] foo"}, 0)
+	assert.Equal(t, 0, n)
+}
+
+func TestExtractSoong(t *testing.T) {
+	e, n := extractSoong([]string{"error: frameworks/base/Android.bp:12:1: module \"foo\": depends on undefined module \"bar\""}, 0)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, "frameworks/base/Android.bp", e.File)
+	assert.Equal(t, int64(12), e.Line)
+
+	e, n = extractSoong([]string{"internal error: panic in GenerateBuildActions for module \"foo\""}, 0)
+	assert.Equal(t, 1, n)
+	assert.Equal(t, "", e.File)
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/devops-pipeflow/insight-plugin/config"
 	"github.com/devops-pipeflow/insight-plugin/gpt"
+	"github.com/devops-pipeflow/insight-plugin/mail"
 	"github.com/devops-pipeflow/insight-plugin/proto"
 	"github.com/devops-pipeflow/insight-plugin/ssh"
 )
@@ -29,6 +30,10 @@ const (
 	healthScript = "healthcheck.sh"
 	healthSilent = "--silent"
 
+	mailNode  = "nodesight"
+	mailStat  = "nodestat.json"
+	mailStyle = "  "
+
 	routineNum = -1
 )
 
@@ -42,6 +47,7 @@ type NodeSightConfig struct {
 	Config config.Config
 	Logger hclog.Logger
 	Gpt    gpt.Gpt
+	Mail   mail.Mail
 	Ssh    ssh.Ssh
 }
 
@@ -59,15 +65,21 @@ func DefaultNodeSightConfig() *NodeSightConfig {
 	return &NodeSightConfig{}
 }
 
-func (ns *nodesight) Init(_ context.Context) error {
+func (ns *nodesight) Init(ctx context.Context) error {
 	ns.cfg.Logger.Debug("nodesight: Init")
 
+	if err := ns.cfg.Mail.Init(ctx); err != nil {
+		return errors.Wrap(err, "failed to init mail")
+	}
+
 	return nil
 }
 
-func (ns *nodesight) Deinit(_ context.Context) error {
+func (ns *nodesight) Deinit(ctx context.Context) error {
 	ns.cfg.Logger.Debug("nodesight: Deinit")
 
+	_ = ns.cfg.Mail.Deinit(ctx)
+
 	return nil
 }
 
@@ -117,9 +129,40 @@ func (ns *nodesight) Run(ctx context.Context, trigger *proto.NodeTrigger) (proto
 		return nodeInfo, mailInfo, errors.Wrap(err, "failed to wait routine")
 	}
 
+	mailInfo, err := ns.runMail(ctx, trigger, &nodeInfo)
+	if err != nil {
+		ns.cfg.Logger.Warn("nodesight: failed to run mail: " + err.Error())
+	}
+
 	return nodeInfo, mailInfo, nil
 }
 
+// runMail composes the mail for the unhealthy node, the node stat is attached in json. No mail
+// is composed for the healthy node.
+func (ns *nodesight) runMail(ctx context.Context, trigger *proto.NodeTrigger, info *proto.NodeInfo) (proto.MailInfo, error) {
+	ns.cfg.Logger.Debug("nodesight: runMail")
+
+	if info.Error == "" {
+		return proto.MailInfo{}, nil
+	}
+
+	title := "node unhealthy on " + trigger.SshConfig.Host
+
+	stat, err := json.MarshalIndent(info.NodeStat, "", mailStyle)
+	if err != nil {
+		return proto.MailInfo{}, errors.Wrap(err, "failed to marshal json")
+	}
+
+	content := mail.Content{
+		Sight:       mailNode,
+		Title:       title,
+		Data:        info,
+		Attachments: map[string][]byte{mailStat: stat},
+	}
+
+	return ns.cfg.Mail.Run(ctx, &content)
+}
+
 func (ns *nodesight) runDetect(ctx context.Context) error {
 	ns.cfg.Logger.Debug("nodesight: runDetect")
 
@@ -9,6 +9,8 @@ import (
 
 	"github.com/devops-pipeflow/insight-plugin/config"
 	"github.com/devops-pipeflow/insight-plugin/gpt"
+	"github.com/devops-pipeflow/insight-plugin/mail"
+	"github.com/devops-pipeflow/insight-plugin/proto"
 	"github.com/devops-pipeflow/insight-plugin/ssh"
 )
 
@@ -25,6 +27,9 @@ func initNodeSight() nodesight {
 		Level: hclog.LevelFromString("INFO"),
 	})
 	ns.cfg.Gpt = gpt.New(ctx, gpt.DefaultConfig())
+	m := mail.DefaultConfig()
+	m.Logger = ns.cfg.Logger
+	ns.cfg.Mail = mail.New(ctx, m)
 	ns.cfg.Ssh = ssh.New(ctx, ssh.DefaultConfig())
 
 	return ns
@@ -61,6 +66,24 @@ func TestNodeSightRunReport(t *testing.T) {
 	assert.Equal(t, nil, nil)
 }
 
+func TestNodeSightRunMail(t *testing.T) {
+	ctx := context.Background()
+	ns := initNodeSight()
+
+	t.Setenv("TMPDIR", t.TempDir())
+
+	trigger := proto.NodeTrigger{SshConfig: proto.SshConfig{Host: "127.0.0.1"}}
+
+	ret, err := ns.runMail(ctx, &trigger, &proto.NodeInfo{})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "", ret.Subject)
+
+	ret, err = ns.runMail(ctx, &trigger, &proto.NodeInfo{Error: "disk full"})
+	assert.Equal(t, nil, err)
+	assert.Equal(t, "[nodesight]: node unhealthy on 127.0.0.1", ret.Subject)
+	assert.Equal(t, 1, len(ret.Attachments))
+}
+
 func TestNodeSightRunClean(t *testing.T) {
 	t.Skip("Skipping TestNodeSightRunClean.")
 }
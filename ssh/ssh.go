@@ -169,14 +169,31 @@ func (s *ssh) deinitSession(_ context.Context) error {
 	return nil
 }
 
-func (s *ssh) runSession(_ context.Context, cmds []string) (string, error) {
+// runSession runs the commands in the session, the session is closed once ctx is done so that
+// the command hung (e.g., healthcheck) is aborted.
+func (s *ssh) runSession(ctx context.Context, cmds []string) (string, error) {
 	s.cfg.Logger.Debug("ssh: runSession")
 
 	if s.session == nil {
 		return "", errors.New("invalid session")
 	}
 
+	done := make(chan struct{})
+	defer close(done)
+
+	go func(session *cryptossh.Session) {
+		select {
+		case <-ctx.Done():
+			_ = session.Close()
+		case <-done:
+		}
+	}(s.session)
+
 	out, err := s.session.CombinedOutput(strings.Join(cmds, operatorAnd))
+	if ctx.Err() != nil {
+		return string(out), errors.Wrap(ctx.Err(), "failed to run cmd")
+	}
+
 	if err != nil {
 		return string(out), errors.Wrap(err, "failed to run cmd")
 	}